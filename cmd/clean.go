@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/anwam/go-template-sh/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+var cleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "Remove a previously generated project",
+	Long: `Remove a project directory generated by go-template-sh.
+
+The directory is only removed when it contains the ` + generator.ManifestFile + ` manifest
+written during generation, for the project of the given name.
+
+Examples:
+  go-template-sh clean --name my-api
+  go-template-sh clean --name my-api --output ./projects --yes`,
+	RunE: runClean,
+}
+
+func init() {
+	rootCmd.AddCommand(cleanCmd)
+
+	cleanCmd.Flags().StringP("name", "n", "", "Name of the generated project to remove")
+	cleanCmd.Flags().StringP("output", "o", ".", "Directory containing the generated project")
+	cleanCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	_ = cleanCmd.MarkFlagRequired("name")
+}
+
+func runClean(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	outputDir, _ := cmd.Flags().GetString("output")
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+
+	projectDir := filepath.Join(outputDir, name)
+	if _, err := readManifest(projectDir, name); err != nil {
+		return err
+	}
+
+	if !skipConfirm {
+		confirmed := false
+		confirmPrompt := &survey.Confirm{
+			Message: fmt.Sprintf("Remove %s and everything in it?", projectDir),
+		}
+		if err := survey.AskOne(confirmPrompt, &confirmed); err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Clean cancelled")
+			return nil
+		}
+	}
+
+	if err := removeGeneratedProject(projectDir, name); err != nil {
+		return err
+	}
+	fmt.Printf("🧹 Removed %s\n", projectDir)
+	return nil
+}
+
+// removeGeneratedProject removes projectDir after checking that it holds the
+// generated project name, refusing directories without a matching manifest.
+func removeGeneratedProject(projectDir, name string) error {
+	if _, err := readManifest(projectDir, name); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(projectDir); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", projectDir, err)
+	}
+	return nil
+}
+
+// readManifest reads the generation manifest of projectDir and checks that
+// it was written for the project name.
+func readManifest(projectDir, name string) (*generator.Manifest, error) {
+	info, err := os.Stat(projectDir)
+	if err != nil {
+		return nil, fmt.Errorf("project directory %s not found: %w", projectDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", projectDir)
+	}
+
+	manifest, err := generator.ReadManifest(projectDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("refusing to remove %s: no %s manifest, so it does not look generated", projectDir, generator.ManifestFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("refusing to remove %s: %w", projectDir, err)
+	}
+	if manifest.ProjectName != name {
+		return nil, fmt.Errorf("refusing to remove %s: %s was generated for project %q", projectDir, generator.ManifestFile, manifest.ProjectName)
+	}
+	return manifest, nil
+}
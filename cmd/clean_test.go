@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anwam/go-template-sh/internal/generator"
+)
+
+func TestRemoveGeneratedProject_RefusesWithoutManifest(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "demo")
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	err := removeGeneratedProject(dir, "demo")
+	if err == nil || !strings.Contains(err.Error(), "does not look generated") {
+		t.Fatalf("removeGeneratedProject error = %v, want a missing manifest error", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "src")); err != nil {
+		t.Errorf("directory without manifest should be left untouched: %v", err)
+	}
+}
+
+func TestRemoveGeneratedProject_RefusesOtherProject(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "demo")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, generator.ManifestFile), []byte(`{"ProjectName": "other"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := removeGeneratedProject(dir, "demo"); err == nil {
+		t.Fatal("removeGeneratedProject should refuse a manifest of another project")
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("directory should be left untouched: %v", err)
+	}
+}
+
+func TestRemoveGeneratedProject(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "demo")
+	if err := os.MkdirAll(filepath.Join(dir, "internal"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, generator.ManifestFile), []byte(`{"ProjectName": "demo"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := removeGeneratedProject(dir, "demo"); err != nil {
+		t.Fatalf("removeGeneratedProject failed: %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("generated project should be removed, stat error = %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CommandRunner runs an external command in a directory.
+type CommandRunner interface {
+	Run(dir, name string, args ...string) error
+}
+
+type execRunner struct{}
+
+func (execRunner) Run(dir, name string, args ...string) error {
+	c := exec.Command(name, args...)
+	c.Dir = dir
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
+}
+
+var (
+	// runner executes post-generation commands; tests replace it with a fake.
+	runner CommandRunner = execRunner{}
+
+	// networkAvailable reports whether the Go module proxy is reachable.
+	networkAvailable = func() bool {
+		conn, err := net.DialTimeout("tcp", "proxy.golang.org:443", 3*time.Second)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+)
+
+// vendorDependencies resolves modules and vendors them into projectDir,
+// producing go.sum and vendor/. It is skipped when the network is unavailable.
+// go mod tidy is used rather than go mod download because the generated go.mod
+// does not list indirect requirements.
+func vendorDependencies(projectDir string) error {
+	if !networkAvailable() {
+		fmt.Println("⚠️  Network unavailable - skipping dependency vendoring")
+		return nil
+	}
+
+	fmt.Println("📦 Vendoring dependencies...")
+	steps := [][]string{
+		{"mod", "tidy"},
+		{"mod", "vendor"},
+	}
+	for _, args := range steps {
+		if err := runner.Run(projectDir, "go", args...); err != nil {
+			return fmt.Errorf("go %s %s failed: %w", args[0], args[1], err)
+		}
+	}
+	return nil
+}
+
+// gitRemoteHosts are the hosts whose module paths map directly to a clone URL.
+var gitRemoteHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// majorVersionSuffix matches the /vN element of a module path.
+var majorVersionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+
+// gitRemoteURL derives the HTTPS clone URL from a module path such as
+// github.com/org/repo/v2. It reports false for unrecognized hosts.
+// GitLab allows nested groups, so only a major version suffix is dropped there;
+// on the other hosts the repository is always host/owner/repo.
+func gitRemoteURL(modulePath string) (string, bool) {
+	parts := strings.Split(modulePath, "/")
+	if len(parts) < 3 || !slices.Contains(gitRemoteHosts, parts[0]) {
+		return "", false
+	}
+
+	repo := parts[:3]
+	if parts[0] == "gitlab.com" {
+		repo = parts
+		if len(parts) > 3 && majorVersionSuffix.MatchString(parts[len(parts)-1]) {
+			repo = parts[:len(parts)-1]
+		}
+	}
+	return "https://" + strings.Join(repo, "/") + ".git", true
+}
+
+// initGitRemote runs git init in projectDir and adds an origin remote derived
+// from modulePath. The remote is skipped when the host is not recognized.
+func initGitRemote(projectDir, modulePath string) error {
+	fmt.Println("🔧 Initializing git repository...")
+	if err := runner.Run(projectDir, "git", "init"); err != nil {
+		return fmt.Errorf("git init failed: %w", err)
+	}
+
+	url, ok := gitRemoteURL(modulePath)
+	if !ok {
+		fmt.Printf("⚠️  Cannot derive a git remote from %s - skipping origin\n", modulePath)
+		return nil
+	}
+	if err := runner.Run(projectDir, "git", "remote", "add", "origin", url); err != nil {
+		return fmt.Errorf("git remote add failed: %w", err)
+	}
+	return nil
+}
+
+// findWorkspaceRoot returns the directory of the nearest go.work in dir or
+// one of its parents.
+func findWorkspaceRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.work")); err == nil {
+			return d, nil
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("--workspace requires a go.work in or above %s (run go work init at the repository root)", abs)
+		}
+	}
+}
+
+// addToWorkspace runs go work use in workspaceRoot for projectDir, so the
+// generated module builds as part of the workspace rather than beside it.
+func addToWorkspace(workspaceRoot, projectDir string) error {
+	abs, err := filepath.Abs(projectDir)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(workspaceRoot, abs)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("🧩 Adding the project to go.work...")
+	if err := runner.Run(workspaceRoot, "go", "work", "use", "./"+filepath.ToSlash(rel)); err != nil {
+		return fmt.Errorf("go work use failed: %w", err)
+	}
+	return nil
+}
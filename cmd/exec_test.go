@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type fakeRunner struct {
+	calls []string
+	err   error
+}
+
+func (f *fakeRunner) Run(dir, name string, args ...string) error {
+	f.calls = append(f.calls, dir+": "+name+" "+strings.Join(args, " "))
+	return f.err
+}
+
+func withFakes(t *testing.T, r CommandRunner, online bool) {
+	t.Helper()
+	origRunner, origNetwork := runner, networkAvailable
+	runner = r
+	networkAvailable = func() bool { return online }
+	t.Cleanup(func() {
+		runner, networkAvailable = origRunner, origNetwork
+	})
+}
+
+func TestVendorDependencies(t *testing.T) {
+	fake := &fakeRunner{}
+	withFakes(t, fake, true)
+
+	if err := vendorDependencies("/tmp/demo"); err != nil {
+		t.Fatalf("vendorDependencies failed: %v", err)
+	}
+
+	want := []string{
+		"/tmp/demo: go mod tidy",
+		"/tmp/demo: go mod vendor",
+	}
+	if strings.Join(fake.calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %q, want %q", fake.calls, want)
+	}
+}
+
+func TestVendorDependencies_Offline(t *testing.T) {
+	fake := &fakeRunner{}
+	withFakes(t, fake, false)
+
+	if err := vendorDependencies("/tmp/demo"); err != nil {
+		t.Fatalf("vendorDependencies failed: %v", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("expected no commands when offline, got %q", fake.calls)
+	}
+}
+
+func TestVendorDependencies_Error(t *testing.T) {
+	fake := &fakeRunner{err: errors.New("exit status 1")}
+	withFakes(t, fake, true)
+
+	err := vendorDependencies("/tmp/demo")
+	if err == nil || !strings.Contains(err.Error(), "go mod tidy") {
+		t.Fatalf("expected go mod tidy error, got %v", err)
+	}
+	if len(fake.calls) != 1 {
+		t.Errorf("expected vendoring to stop after the first failure, got %q", fake.calls)
+	}
+}
+
+func TestGitRemoteURL(t *testing.T) {
+	tests := []struct {
+		modulePath string
+		want       string
+		wantOK     bool
+	}{
+		{"github.com/org/repo", "https://github.com/org/repo.git", true},
+		{"github.com/org/repo/v2", "https://github.com/org/repo.git", true},
+		{"github.com/org/repo/internal/tools", "https://github.com/org/repo.git", true},
+		{"gitlab.com/group/repo", "https://gitlab.com/group/repo.git", true},
+		{"gitlab.com/group/subgroup/repo", "https://gitlab.com/group/subgroup/repo.git", true},
+		{"gitlab.com/group/subgroup/repo/v3", "https://gitlab.com/group/subgroup/repo.git", true},
+		{"bitbucket.org/team/repo", "https://bitbucket.org/team/repo.git", true},
+		{"github.com/org", "", false},
+		{"example.com/org/repo", "", false},
+		{"my-project", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modulePath, func(t *testing.T) {
+			got, ok := gitRemoteURL(tt.modulePath)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("gitRemoteURL(%q) = %q, %v; want %q, %v", tt.modulePath, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestInitGitRemote(t *testing.T) {
+	fake := &fakeRunner{}
+	withFakes(t, fake, true)
+
+	if err := initGitRemote("/tmp/demo", "github.com/org/repo"); err != nil {
+		t.Fatalf("initGitRemote failed: %v", err)
+	}
+
+	want := []string{
+		"/tmp/demo: git init",
+		"/tmp/demo: git remote add origin https://github.com/org/repo.git",
+	}
+	if strings.Join(fake.calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %q, want %q", fake.calls, want)
+	}
+}
+
+func TestInitGitRemote_UnknownHost(t *testing.T) {
+	fake := &fakeRunner{}
+	withFakes(t, fake, true)
+
+	if err := initGitRemote("/tmp/demo", "example.com/org/repo"); err != nil {
+		t.Fatalf("initGitRemote failed: %v", err)
+	}
+
+	want := []string{"/tmp/demo: git init"}
+	if strings.Join(fake.calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %q, want %q", fake.calls, want)
+	}
+}
+
+func TestFindWorkspaceRoot(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.work"), []byte("go 1.23\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	services := filepath.Join(root, "services", "billing")
+	if err := os.MkdirAll(services, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := findWorkspaceRoot(services)
+	if err != nil {
+		t.Fatalf("findWorkspaceRoot failed: %v", err)
+	}
+	if got != root {
+		t.Errorf("findWorkspaceRoot = %q, want %q", got, root)
+	}
+}
+
+func TestFindWorkspaceRoot_Missing(t *testing.T) {
+	if _, err := findWorkspaceRoot(t.TempDir()); err == nil || !strings.Contains(err.Error(), "go work init") {
+		t.Fatalf("expected a missing go.work error, got %v", err)
+	}
+}
+
+func TestAddToWorkspace(t *testing.T) {
+	fake := &fakeRunner{}
+	withFakes(t, fake, true)
+
+	if err := addToWorkspace("/tmp/mono", "/tmp/mono/services/demo"); err != nil {
+		t.Fatalf("addToWorkspace failed: %v", err)
+	}
+
+	want := []string{"/tmp/mono: go work use ./services/demo"}
+	if strings.Join(fake.calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls = %q, want %q", fake.calls, want)
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/anwam/go-template-sh/internal/config"
+	"github.com/anwam/go-template-sh/internal/fsys"
+	"github.com/anwam/go-template-sh/internal/generator"
+)
+
+// previewFile generates the project in memory and writes the file at the
+// project-relative path to w, leaving the disk untouched.
+func previewFile(cfg *config.Config, path string, w io.Writer) error {
+	mfs := fsys.NewMemory()
+	gen := generator.New(cfg, "/", generator.WithFileSystem(mfs), generator.WithVersion(Version))
+	if err := gen.Generate(); err != nil {
+		return fmt.Errorf("failed to generate project: %w", err)
+	}
+
+	content, err := mfs.ReadFile(filepath.Join("/", cfg.ProjectName, filepath.FromSlash(path)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%s is not generated for this configuration", path)
+	} else if err != nil {
+		return err
+	}
+
+	_, err = w.Write(content)
+	return err
+}
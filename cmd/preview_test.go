@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/anwam/go-template-sh/internal/config"
+)
+
+func TestPreviewFile(t *testing.T) {
+	cfg := &config.Config{
+		ProjectName:  "demo",
+		ModulePath:   "github.com/test/demo",
+		GoVersion:    "1.23",
+		Framework:    "chi",
+		Logger:       "slog",
+		ConfigFormat: "env",
+	}
+
+	var out bytes.Buffer
+	if err := previewFile(cfg, "internal/server/server.go", &out); err != nil {
+		t.Fatalf("previewFile failed: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "package server\n") || !strings.Contains(out.String(), "chi.NewRouter()") {
+		t.Errorf("preview should print the chi server file, got:\n%s", out.String())
+	}
+
+	err := previewFile(cfg, "internal/missing.go", &out)
+	if err == nil || !strings.Contains(err.Error(), "internal/missing.go is not generated") {
+		t.Errorf("previewing a missing file should fail, got %v", err)
+	}
+}
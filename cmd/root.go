@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/anwam/go-template-sh/internal/config"
@@ -28,6 +31,9 @@ Examples:
   # Dry-run to see what would be generated
   go-template-sh --name my-api --dry-run
 
+  # Print a single generated file without writing anything
+  go-template-sh --name my-api --framework gin --preview internal/server/server.go
+
   # Show version
   go-template-sh version`,
 	RunE: runGenerate,
@@ -60,21 +66,79 @@ func init() {
 
 	// Project configuration
 	rootCmd.Flags().String("go-version", "1.23", "Go version (1.21, 1.22, 1.23, 1.24)")
-	rootCmd.Flags().StringP("framework", "f", "stdlib", "HTTP framework (stdlib, chi, gin, echo, fiber)")
-	rootCmd.Flags().StringSlice("database", nil, "Database(s) to include (postgres, mysql, mongodb, redis)")
+	rootCmd.Flags().StringP("framework", "f", "stdlib", "HTTP framework (stdlib, chi, gin, echo, fiber, fasthttp)")
+	rootCmd.Flags().StringSlice("database", nil, "Database(s) to include (postgres, mysql, mongodb, redis, or none)")
 	rootCmd.Flags().StringP("logger", "l", "slog", "Logger (slog, zap, zerolog)")
-	rootCmd.Flags().String("config-format", "env", "Config format (env, yaml, json, toml)")
-	rootCmd.Flags().String("ci", "", "CI/CD configuration (github, gitlab, or empty for none)")
+	rootCmd.Flags().String("access-log-format", "structured", "Request log format (structured, common, combined)")
+	rootCmd.Flags().String("log-format", "json", "Log format (json, or stackdriver for GKE/Cloud Run; requires --logger slog or zap)")
+	rootCmd.Flags().StringSlice("trusted-proxies", nil, "IPs or CIDRs of the proxies whose X-Forwarded-For header is trusted (not fasthttp)")
+	rootCmd.Flags().Bool("health-detail", false, "Generate a /healthz/detailed endpoint reporting uptime, Go version, and build info")
+	rootCmd.Flags().Bool("websocket", false, "Generate a /ws WebSocket endpoint echoing messages back")
+	rootCmd.Flags().Bool("http2", false, "Serve plaintext HTTP/2 (h2c) alongside HTTP/1.1 (stdlib and chi only)")
+	rootCmd.Flags().Uint32("http2-max-concurrent-streams", 0, "Default HTTP/2 streams per connection (0 keeps the x/net/http2 default of 250; requires --http2; HTTP2_MAX_CONCURRENT_STREAMS overrides)")
+	rootCmd.Flags().Bool("export-middleware", false, "Emit RequestID, Logger, and Recoverer as the importable pkg/httpmw package (stdlib only)")
+	rootCmd.Flags().Bool("disable-uuid", false, "Generate request IDs with crypto/rand instead of github.com/google/uuid")
+	rootCmd.Flags().String("config-format", "env", "Config format (env, yaml, json, toml, hcl)")
+	rootCmd.Flags().String("config-validation", "lenient", "Config file validation (lenient, or strict to reject unknown keys)")
+	rootCmd.Flags().String("db-config-style", "url", "PostgreSQL config style (url, or discrete DB_HOST, DB_PORT, ... fields)")
+	rootCmd.Flags().String("db-ssl-mode", "disable", "TLS mode of the default database connection settings (disable, require, verify-full)")
+	rootCmd.Flags().String("env-prefix", "", "Prefix for generated environment variables (e.g., MYAPP for MYAPP_PORT)")
+	rootCmd.Flags().Bool("config-schema", false, "Generate config.schema.json for editor validation (yaml, json, toml)")
+	rootCmd.Flags().Bool("env-schema", false, "Generate internal/config/schema.go checking every env var at startup (env config format)")
+	rootCmd.Flags().Int("port", config.DefaultPort, "Default HTTP port of the generated app")
+	rootCmd.Flags().Int("container-port", 0, "Port the app listens on inside the container (defaults to --port)")
+	rootCmd.Flags().String("author", "", "Copyright holder added to the header of generated Go files")
+	rootCmd.Flags().String("author-email", "", "Author email included in the copyright header")
+	rootCmd.Flags().String("header", "", "License header added to generated Go files (e.g., \"SPDX-License-Identifier: MIT\")")
+	rootCmd.Flags().StringSlice("ci", nil, "CI/CD configuration(s) to generate (github, gitlab, or both as github,gitlab)")
 
 	// Feature flags
 	rootCmd.Flags().Bool("tracing", true, "Enable OpenTelemetry tracing")
+	rootCmd.Flags().Bool("otel-logs", false, "Export slog logs over OTLP alongside traces (requires --logger slog and --tracing)")
+	rootCmd.Flags().Bool("otlp-headers", false, "Send OTLP_HEADERS (key=value,...) with OTLP exports, for authenticated collectors")
+	rootCmd.Flags().Bool("otlp-secure", false, "Export OTLP over TLS instead of an insecure connection")
 	rootCmd.Flags().Bool("metrics", true, "Enable Prometheus metrics")
+	rootCmd.Flags().String("metrics-namespace", "", "Prometheus namespace for metric names (e.g., myapp for myapp_http_requests_total)")
+	rootCmd.Flags().String("metrics-subsystem", "", "Prometheus subsystem for metric names (e.g., api)")
+	rootCmd.Flags().String("metrics-type", "histogram", "Request latency metric type (histogram, summary)")
+	rootCmd.Flags().Float64Slice("metrics-objectives", nil, "Quantiles of the latency summary (empty uses 0.5,0.9,0.99; requires --metrics-type summary)")
 	rootCmd.Flags().Bool("docker", true, "Generate Dockerfile and docker-compose.yml")
 	rootCmd.Flags().Bool("env-sample", true, "Generate documented .env.example file")
+	rootCmd.Flags().Bool("examples", false, "Generate examples/requests.http and examples/curl.sh exercising every endpoint")
+	rootCmd.Flags().Bool("procfile", false, "Generate a Procfile declaring the web process for Heroku/Foreman-style deploys")
+	rootCmd.Flags().Bool("vuln-check", false, "Run govulncheck in the generated CI pipeline and make ci")
+	rootCmd.Flags().Bool("sbom", false, "Generate a make sbom target and CI job writing an SPDX SBOM with syft")
+	rootCmd.Flags().Bool("vscode", false, "Generate .vscode/launch.json and settings.json for debugging and linting in VS Code")
+	rootCmd.Flags().Bool("nix", false, "Generate flake.nix with a dev shell providing the pinned Go version, golangci-lint, and mockgen")
+	rootCmd.Flags().Bool("signals", false, "Cycle the log level (debug, info, warn, error) on SIGUSR1 without a restart (Unix only)")
+	rootCmd.Flags().Bool("log-sampling", false, "Sample logs to bound their volume: per second, the first 100 entries then every 100th")
+	rootCmd.Flags().Bool("build-tags", false, "Generate cmd/<name>/pprof_debug.go serving pprof only in binaries built with -tags debug (make build-debug)")
+	rootCmd.Flags().Bool("tx-helper", false, "Generate internal/database/tx.go with a WithTx transaction helper (requires postgres or mysql)")
+	rootCmd.Flags().Bool("validator", false, "Generate pkg/validate with go-playground/validator and an example POST handler")
+	rootCmd.Flags().StringArray("replace", nil, "Add a go.mod replace directive, old=new with new a local directory or module@version (repeatable, e.g., github.com/foo/bar=../bar)")
+	rootCmd.Flags().Bool("private", false, "Module is behind a private proxy: set GOPRIVATE to the module root in make deps and CI")
+	rootCmd.Flags().String("password-hash", "", "Generate pkg/hash hashing passwords with bcrypt or argon2 (empty to skip)")
+	rootCmd.Flags().Bool("content-negotiation", false, "Respond in JSON or XML according to the Accept header (stdlib and chi only)")
+	rootCmd.Flags().Bool("disable-recover", false, "Recover panics only when ENVIRONMENT is production, letting them propagate in tests and development")
+	rootCmd.Flags().Bool("cors", false, "Generate CORS middleware (localhost allowed in development, deny by default elsewhere)")
+	rootCmd.Flags().Bool("probe-aliases", false, "Also serve /livez and /readyz for Kubernetes probes")
+	rootCmd.Flags().Duration("graceful-drain-delay", 0, "Delay between failing readiness and shutdown on SIGTERM (e.g., 5s)")
+	rootCmd.Flags().Duration("read-header-timeout", config.DefaultReadHeaderTimeout, "Time the server allows for reading request headers")
+	rootCmd.Flags().Int("max-header-bytes", 0, "Maximum request header size in bytes (0 keeps the net/http default of 1 MiB)")
+	rootCmd.Flags().StringToString("timeout-per-route", nil, "Request timeout per exact path overriding the 60s default (e.g., /reports=2m,/health=2s; stdlib, chi, and echo only)")
+	rootCmd.Flags().Int("max-inflight", 0, "Cap concurrently served requests, answering 503 beyond it (0 omits the limiter; MAX_INFLIGHT overrides)")
+	rootCmd.Flags().String("trace-id-header", "", "Echo the trace ID, or the request ID without tracing, in this response header (e.g., X-Trace-ID)")
+	rootCmd.Flags().Duration("slow-request-threshold", 0, "Log requests slower than this at warn level (e.g., 500ms, 0 disables)")
+	rootCmd.Flags().Bool("no-comments", false, "Strip explanatory comments from generated Go files (keeping directives like //go:generate) and trim the .env and config examples")
+	rootCmd.Flags().Bool("vendor", false, "Run go mod tidy and go mod vendor after generation (requires network)")
+	rootCmd.Flags().Bool("init-git-remote", false, "Run git init and set origin from the module path (github.com, gitlab.com, bitbucket.org)")
+	rootCmd.Flags().Bool("workspace", false, "Add the project to the go.work in or above the output directory with go work use (for monorepos)")
 
 	// Mode flags
 	rootCmd.Flags().Bool("dry-run", false, "Show what would be generated without writing files")
+	rootCmd.Flags().String("preview", "", "Print a single generated file (e.g., internal/server/server.go) without writing files")
 	rootCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt (non-interactive)")
+	rootCmd.Flags().Bool("interactive-summary-edit", true, "Offer to edit individual answers after the interactive prompts")
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
@@ -82,6 +146,7 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	skipConfirm, _ := cmd.Flags().GetBool("yes")
 	outputDir, _ := cmd.Flags().GetString("output")
+	preview, _ := cmd.Flags().GetString("preview")
 
 	// Try to build config from flags first
 	cfg, isNonInteractive, err := buildConfigFromFlags(cmd)
@@ -89,6 +154,17 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Previews print only the file, so they skip the banner and summaries
+	if preview != "" {
+		if !isNonInteractive {
+			return fmt.Errorf("--preview requires --name")
+		}
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+		return previewFile(cfg, preview, cmd.OutOrStdout())
+	}
+
 	if isNonInteractive {
 		// Non-interactive mode - use flags only
 		fmt.Println("🚀 go-template-sh - Go HTTP Server Template Generator")
@@ -103,6 +179,9 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to collect configuration: %w", err)
 		}
+		cfg.Vendor, _ = cmd.Flags().GetBool("vendor")
+		cfg.InitGitRemote, _ = cmd.Flags().GetBool("init-git-remote")
+		cfg.Workspace, _ = cmd.Flags().GetBool("workspace")
 	}
 
 	// Validate configuration
@@ -113,6 +192,21 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	// Show configuration summary
 	printConfigSummary(cfg, outputDir, dryRun)
 
+	// Let interactive users revisit individual answers before generating
+	summaryEdit, _ := cmd.Flags().GetBool("interactive-summary-edit")
+	if summaryEdit && !isNonInteractive && !skipConfirm {
+		showSummary := func(c *config.Config) {
+			fmt.Println()
+			printConfigSummary(c, outputDir, dryRun)
+		}
+		if err := prompt.EditConfiguration(cfg, showSummary); err != nil {
+			return err
+		}
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+	}
+
 	if dryRun {
 		fmt.Println()
 		fmt.Println("🔍 Dry-run mode - no files will be written")
@@ -137,18 +231,48 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	gen := generator.New(cfg, outputDir)
+	// Look for the workspace before writing anything, so a missing go.work
+	// does not leave behind a project outside of it
+	var workspaceRoot string
+	if cfg.Workspace {
+		workspaceRoot, err = findWorkspaceRoot(outputDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	gen := generator.New(cfg, outputDir, generator.WithVersion(Version))
 	if err := gen.Generate(); err != nil {
 		return fmt.Errorf("failed to generate project: %w", err)
 	}
 
+	if cfg.Vendor {
+		if err := vendorDependencies(filepath.Join(outputDir, cfg.ProjectName)); err != nil {
+			return fmt.Errorf("failed to vendor dependencies: %w", err)
+		}
+	}
+
+	if cfg.InitGitRemote {
+		if err := initGitRemote(filepath.Join(outputDir, cfg.ProjectName), cfg.ModulePath); err != nil {
+			return fmt.Errorf("failed to initialize git repository: %w", err)
+		}
+	}
+
+	if cfg.Workspace {
+		if err := addToWorkspace(workspaceRoot, filepath.Join(outputDir, cfg.ProjectName)); err != nil {
+			return fmt.Errorf("failed to add the project to go.work: %w", err)
+		}
+	}
+
 	fmt.Println()
 	fmt.Println("✅ Project generated successfully!")
 	fmt.Printf("📁 Location: %s/%s\n", outputDir, cfg.ProjectName)
 	fmt.Println()
 	fmt.Println("Next steps:")
 	fmt.Printf("  cd %s\n", cfg.ProjectName)
-	fmt.Println("  go mod download")
+	if !cfg.Vendor {
+		fmt.Println("  go mod download")
+	}
 	fmt.Println("  make run")
 	fmt.Println()
 
@@ -185,16 +309,101 @@ func buildConfigFromFlags(cmd *cobra.Command) (*config.Config, bool, error) {
 	cfg.Framework = framework
 
 	databases, _ := cmd.Flags().GetStringSlice("database")
+	if slices.Contains(databases, "none") {
+		if len(databases) > 1 {
+			return nil, true, fmt.Errorf("--database none cannot be combined with other databases")
+		}
+		databases = []string{}
+	}
 	cfg.Databases = databases
 
 	logger, _ := cmd.Flags().GetString("logger")
 	cfg.Logger = logger
 
+	accessLogFormat, _ := cmd.Flags().GetString("access-log-format")
+	cfg.AccessLogFormat = accessLogFormat
+
+	logFormat, _ := cmd.Flags().GetString("log-format")
+	cfg.LogFormat = logFormat
+
+	disableUUID, _ := cmd.Flags().GetBool("disable-uuid")
+	cfg.DisableUUID = disableUUID
+
+	trustedProxies, _ := cmd.Flags().GetStringSlice("trusted-proxies")
+	cfg.TrustedProxies = trustedProxies
+
+	healthDetail, _ := cmd.Flags().GetBool("health-detail")
+	cfg.HealthDetail = healthDetail
+
+	websocket, _ := cmd.Flags().GetBool("websocket")
+	cfg.EnableWebSocket = websocket
+
+	http2, _ := cmd.Flags().GetBool("http2")
+	cfg.HTTP2 = http2
+	maxConcurrentStreams, _ := cmd.Flags().GetUint32("http2-max-concurrent-streams")
+	cfg.MaxConcurrentStreams = maxConcurrentStreams
+
+	exportMiddleware, _ := cmd.Flags().GetBool("export-middleware")
+	cfg.ExportMiddleware = exportMiddleware
+
+	otelLogs, _ := cmd.Flags().GetBool("otel-logs")
+	cfg.OtelLogs = otelLogs
+
+	otlpHeaders, _ := cmd.Flags().GetBool("otlp-headers")
+	cfg.OTLPHeaders = otlpHeaders
+
+	otlpSecure, _ := cmd.Flags().GetBool("otlp-secure")
+	cfg.OTLPSecure = otlpSecure
+
 	configFormat, _ := cmd.Flags().GetString("config-format")
 	cfg.ConfigFormat = configFormat
 
-	ci, _ := cmd.Flags().GetString("ci")
-	cfg.CI = ci
+	configValidation, _ := cmd.Flags().GetString("config-validation")
+	cfg.ConfigValidation = configValidation
+
+	dbConfigStyle, _ := cmd.Flags().GetString("db-config-style")
+	cfg.DBConfigStyle = dbConfigStyle
+
+	dbSSLMode, _ := cmd.Flags().GetString("db-ssl-mode")
+	cfg.DBSSLMode = dbSSLMode
+
+	envPrefix, _ := cmd.Flags().GetString("env-prefix")
+	cfg.EnvPrefix = envPrefix
+
+	metricsNamespace, _ := cmd.Flags().GetString("metrics-namespace")
+	cfg.MetricsNamespace = metricsNamespace
+
+	metricsSubsystem, _ := cmd.Flags().GetString("metrics-subsystem")
+	cfg.MetricsSubsystem = metricsSubsystem
+
+	metricsType, _ := cmd.Flags().GetString("metrics-type")
+	cfg.MetricsType = metricsType
+
+	metricsObjectives, _ := cmd.Flags().GetFloat64Slice("metrics-objectives")
+	cfg.MetricsObjectives = metricsObjectives
+
+	configSchema, _ := cmd.Flags().GetBool("config-schema")
+	cfg.ConfigSchema = configSchema
+	envSchema, _ := cmd.Flags().GetBool("env-schema")
+	cfg.EnvSchema = envSchema
+
+	port, _ := cmd.Flags().GetInt("port")
+	cfg.Port = port
+
+	containerPort, _ := cmd.Flags().GetInt("container-port")
+	cfg.ContainerPort = containerPort
+
+	author, _ := cmd.Flags().GetString("author")
+	cfg.Author = author
+
+	authorEmail, _ := cmd.Flags().GetString("author-email")
+	cfg.AuthorEmail = authorEmail
+
+	header, _ := cmd.Flags().GetString("header")
+	cfg.Header = header
+
+	ci, _ := cmd.Flags().GetStringSlice("ci")
+	cfg.CI = strings.Join(ci, ",")
 
 	tracing, _ := cmd.Flags().GetBool("tracing")
 	cfg.EnableTracing = tracing
@@ -208,6 +417,97 @@ func buildConfigFromFlags(cmd *cobra.Command) (*config.Config, bool, error) {
 	envSample, _ := cmd.Flags().GetBool("env-sample")
 	cfg.EnvSample = envSample
 
+	validator, _ := cmd.Flags().GetBool("validator")
+	cfg.Validator = validator
+
+	examples, _ := cmd.Flags().GetBool("examples")
+	cfg.Examples = examples
+
+	procfile, _ := cmd.Flags().GetBool("procfile")
+	cfg.Procfile = procfile
+
+	vulnCheck, _ := cmd.Flags().GetBool("vuln-check")
+	cfg.VulnCheck = vulnCheck
+
+	sbom, _ := cmd.Flags().GetBool("sbom")
+	cfg.SBOM = sbom
+
+	vscode, _ := cmd.Flags().GetBool("vscode")
+	cfg.VSCode = vscode
+
+	nix, _ := cmd.Flags().GetBool("nix")
+	cfg.Nix = nix
+
+	signals, _ := cmd.Flags().GetBool("signals")
+	cfg.Signals = signals
+	logSampling, _ := cmd.Flags().GetBool("log-sampling")
+	cfg.LogSampling = logSampling
+
+	txHelper, _ := cmd.Flags().GetBool("tx-helper")
+	cfg.TxHelper = txHelper
+
+	buildTags, _ := cmd.Flags().GetBool("build-tags")
+	cfg.BuildTags = buildTags
+
+	passwordHash, _ := cmd.Flags().GetString("password-hash")
+	cfg.PasswordHash = passwordHash
+
+	private, _ := cmd.Flags().GetBool("private")
+	cfg.Private = private
+
+	replaces, _ := cmd.Flags().GetStringArray("replace")
+	cfg.Replaces = replaces
+
+	contentNegotiation, _ := cmd.Flags().GetBool("content-negotiation")
+	cfg.ContentNegotiation = contentNegotiation
+
+	disableRecover, _ := cmd.Flags().GetBool("disable-recover")
+	cfg.DisableRecover = disableRecover
+
+	cors, _ := cmd.Flags().GetBool("cors")
+	cfg.EnableCORS = cors
+
+	probeAliases, _ := cmd.Flags().GetBool("probe-aliases")
+	cfg.ProbeAliases = probeAliases
+
+	drainDelay, _ := cmd.Flags().GetDuration("graceful-drain-delay")
+	cfg.DrainDelay = drainDelay
+
+	slowRequestThreshold, _ := cmd.Flags().GetDuration("slow-request-threshold")
+	cfg.SlowRequestThreshold = slowRequestThreshold
+
+	readHeaderTimeout, _ := cmd.Flags().GetDuration("read-header-timeout")
+	cfg.ReadHeaderTimeout = readHeaderTimeout
+
+	maxHeaderBytes, _ := cmd.Flags().GetInt("max-header-bytes")
+	cfg.MaxHeaderBytes = maxHeaderBytes
+	timeoutPerRoute, _ := cmd.Flags().GetStringToString("timeout-per-route")
+	if len(timeoutPerRoute) > 0 {
+		cfg.RouteTimeouts = make(map[string]time.Duration, len(timeoutPerRoute))
+		for path, value := range timeoutPerRoute {
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, true, fmt.Errorf("invalid --timeout-per-route timeout for %s: %w", path, err)
+			}
+			cfg.RouteTimeouts[path] = timeout
+		}
+	}
+	maxInflight, _ := cmd.Flags().GetInt("max-inflight")
+	cfg.MaxInflight = maxInflight
+	traceIDHeader, _ := cmd.Flags().GetString("trace-id-header")
+	cfg.TraceIDHeader = traceIDHeader
+
+	noComments, _ := cmd.Flags().GetBool("no-comments")
+	cfg.NoComments = noComments
+
+	vendor, _ := cmd.Flags().GetBool("vendor")
+	cfg.Vendor = vendor
+
+	initGitRemote, _ := cmd.Flags().GetBool("init-git-remote")
+	cfg.InitGitRemote = initGitRemote
+	workspace, _ := cmd.Flags().GetBool("workspace")
+	cfg.Workspace = workspace
+
 	return cfg, true, nil
 }
 
@@ -219,6 +519,10 @@ func printConfigSummary(cfg *config.Config, outputDir string, dryRun bool) {
 	fmt.Printf("  Go Version:  %s\n", cfg.GoVersion)
 	fmt.Printf("  Framework:   %s\n", cfg.Framework)
 	fmt.Printf("  Logger:      %s\n", cfg.Logger)
+	fmt.Printf("  Port:        %d\n", cfg.AppPort())
+	if cfg.Author != "" {
+		fmt.Printf("  Author:      %s\n", cfg.Author)
+	}
 
 	if len(cfg.Databases) > 0 {
 		fmt.Printf("  Databases:   %s\n", strings.Join(cfg.Databases, ", "))
@@ -231,7 +535,7 @@ func printConfigSummary(cfg *config.Config, outputDir string, dryRun bool) {
 	fmt.Printf("  Docker:      %v\n", cfg.IncludeDocker)
 
 	if cfg.CI != "" {
-		fmt.Printf("  CI/CD:       %s\n", cfg.CI)
+		fmt.Printf("  CI/CD:       %s\n", strings.Join(cfg.CISystems(), ", "))
 	} else {
 		fmt.Printf("  CI/CD:       none\n")
 	}
@@ -251,20 +555,51 @@ func printDryRunSummary(cfg *config.Config) {
 		"go.mod",
 		fmt.Sprintf("cmd/%s/main.go", cfg.ProjectName),
 		"internal/config/config.go",
+		"internal/config/log.go",
+		"internal/config/config_test.go",
 		"internal/server/server.go",
 		"internal/handlers/handlers.go",
 		"internal/middleware/middleware.go",
 		"internal/observability/observability.go",
 		"internal/observability/logger.go",
+		"internal/observability/observability_test.go",
 		"Makefile",
+		"tools/tools.go",
 		"README.md",
 		".gitignore",
+		".gitattributes",
 		".env.example",
+		generator.ManifestFile,
+	}
+
+	if cfg.BuildTags {
+		files = append(files, fmt.Sprintf("cmd/%s/pprof_debug.go", cfg.ProjectName))
+	}
+	if cfg.Validator {
+		files = append(files, "pkg/validate/validate.go")
+	}
+	if cfg.ExportMiddleware {
+		files = append(files, "pkg/httpmw/httpmw.go", "pkg/httpmw/httpmw_test.go")
+	}
+	if cfg.PasswordHash != "" {
+		files = append(files, "pkg/hash/hash.go", "pkg/hash/hash_test.go")
+	}
+	if cfg.Examples {
+		files = append(files, "examples/requests.http", "examples/curl.sh")
+	}
+	if cfg.Procfile {
+		files = append(files, "Procfile")
+	}
+	if cfg.VSCode {
+		files = append(files, ".vscode/launch.json", ".vscode/settings.json")
+	}
+	if cfg.Nix {
+		files = append(files, "flake.nix")
 	}
 
 	// Database files
 	if cfg.HasDatabase("postgres") {
-		files = append(files, "internal/database/postgres.go")
+		files = append(files, "internal/database/postgres.go", "internal/database/postgres_close_test.go")
 	}
 	if cfg.HasDatabase("mysql") {
 		files = append(files, "internal/database/mysql.go")
@@ -272,22 +607,33 @@ func printDryRunSummary(cfg *config.Config) {
 	if cfg.HasDatabase("mongodb") {
 		files = append(files, "internal/database/mongodb.go")
 	}
+	if cfg.TxHelper {
+		files = append(files, "internal/database/tx.go")
+	}
 	if cfg.HasDatabase("redis") {
-		files = append(files, "internal/cache/redis.go")
+		files = append(files, "internal/cache/redis.go", "internal/cache/redis_close_test.go")
 	}
 
 	// Docker files
 	if cfg.IncludeDocker {
 		files = append(files, "Dockerfile", "docker-compose.yml", ".dockerignore")
+		if len(cfg.Databases) > 0 {
+			files = append(files, "scripts/wait-for-it.sh")
+		}
 	}
 
 	// CI files
-	if cfg.CI == "github" {
+	if cfg.HasCI("github") {
 		files = append(files, ".github/workflows/ci.yml")
-	} else if cfg.CI == "gitlab" {
+	}
+	if cfg.HasCI("gitlab") {
 		files = append(files, ".gitlab-ci.yml")
 	}
 
+	if cfg.Vendor {
+		files = append(files, "go.sum")
+	}
+
 	// Config files
 	if cfg.ConfigFormat == "yaml" {
 		files = append(files, "config.yaml.example")
@@ -295,6 +641,20 @@ func printDryRunSummary(cfg *config.Config) {
 		files = append(files, "config.json.example")
 	} else if cfg.ConfigFormat == "toml" {
 		files = append(files, "config.toml.example")
+	} else if cfg.ConfigFormat == "hcl" {
+		files = append(files, "config.hcl.example")
+	}
+	if cfg.ConfigFormat != "" && cfg.ConfigFormat != "env" {
+		files = append(files, "internal/config/env.go", "internal/config/testdata/config."+cfg.ConfigFormat, "cmd/configdiff/main.go")
+	}
+	if cfg.DiscretePostgresConfig() {
+		files = append(files, "internal/config/dsn.go")
+	}
+	if cfg.ConfigSchema {
+		files = append(files, "config.schema.json")
+	}
+	if cfg.EnvSchema {
+		files = append(files, "internal/config/schema.go", "internal/config/schema_test.go")
 	}
 
 	for _, f := range files {
@@ -313,6 +673,7 @@ func printDryRunSummary(cfg *config.Config) {
 		"internal/observability",
 		"internal/mocks",
 		"pkg",
+		"tools",
 		"docs",
 	}
 
@@ -322,9 +683,18 @@ func printDryRunSummary(cfg *config.Config) {
 	if cfg.NeedsCache() {
 		dirs = append(dirs, "internal/cache")
 	}
-	if cfg.CI == "github" {
+	if cfg.HasCI("github") {
 		dirs = append(dirs, ".github/workflows")
 	}
+	if cfg.VSCode {
+		dirs = append(dirs, ".vscode")
+	}
+	if cfg.Vendor {
+		dirs = append(dirs, "vendor")
+	}
+	if cfg.InitGitRemote {
+		dirs = append(dirs, ".git")
+	}
 
 	for _, d := range dirs {
 		fmt.Printf("  📁 %s/%s/\n", cfg.ProjectName, d)
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/anwam/go-template-sh/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Add new template files to a previously generated project",
+	Long: `Re-apply the templates to a project generated by go-template-sh.
+
+The project is regenerated with the config recorded in its ` + generator.ManifestFile + `
+manifest, adding files introduced by newer templates while keeping every
+existing file, including ones you edited, unchanged.
+
+Examples:
+  go-template-sh upgrade --name my-api
+  go-template-sh upgrade --name my-api --output ./projects`,
+	RunE: runUpgrade,
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().StringP("name", "n", "", "Name of the generated project to upgrade")
+	upgradeCmd.Flags().StringP("output", "o", ".", "Directory containing the generated project")
+	_ = upgradeCmd.MarkFlagRequired("name")
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	outputDir, _ := cmd.Flags().GetString("output")
+
+	gen, err := upgradeProject(outputDir, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Printf("✅ Upgraded %s\n", filepath.Join(outputDir, name))
+	fmt.Printf("📦 Kept %d existing files unchanged\n", len(gen.SkippedFiles()))
+	fmt.Println()
+
+	return nil
+}
+
+// upgradeProject regenerates the project name in outputDir from its manifest,
+// only adding files that do not exist yet.
+func upgradeProject(outputDir, name string) (*generator.Generator, error) {
+	projectDir := filepath.Join(outputDir, name)
+	if _, err := os.Stat(projectDir); err != nil {
+		return nil, fmt.Errorf("project directory %s not found: %w", projectDir, err)
+	}
+
+	manifest, err := generator.ReadManifest(projectDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("cannot upgrade %s: no %s manifest, so it does not look generated", projectDir, generator.ManifestFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot upgrade %s: %w", projectDir, err)
+	}
+	if manifest.ProjectName != name {
+		return nil, fmt.Errorf("cannot upgrade %s: %s was generated for project %q", projectDir, generator.ManifestFile, manifest.ProjectName)
+	}
+
+	gen := generator.New(manifest.Config, outputDir, generator.WithVersion(Version), generator.WithSkipExisting())
+	if err := gen.Generate(); err != nil {
+		return nil, fmt.Errorf("failed to upgrade project: %w", err)
+	}
+	return gen, nil
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anwam/go-template-sh/internal/config"
+	"github.com/anwam/go-template-sh/internal/generator"
+)
+
+func TestUpgradeProject(t *testing.T) {
+	outputDir := t.TempDir()
+	cfg := &config.Config{
+		ProjectName:  "demo",
+		ModulePath:   "github.com/test/demo",
+		GoVersion:    "1.23",
+		Framework:    "stdlib",
+		Logger:       "slog",
+		ConfigFormat: "env",
+	}
+	if err := generator.New(cfg, outputDir).Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	projectDir := filepath.Join(outputDir, "demo")
+
+	// A file missing from the project stands in for one added by newer templates
+	added := filepath.Join(projectDir, "Makefile")
+	if err := os.Remove(added); err != nil {
+		t.Fatal(err)
+	}
+	edited := filepath.Join(projectDir, "README.md")
+	if err := os.WriteFile(edited, []byte("# My notes\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gen, err := upgradeProject(outputDir, "demo")
+	if err != nil {
+		t.Fatalf("upgradeProject failed: %v", err)
+	}
+
+	if _, err := os.Stat(added); err != nil {
+		t.Errorf("upgrade should add the missing Makefile: %v", err)
+	}
+	readme, err := os.ReadFile(edited)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(readme) != "# My notes\n" {
+		t.Errorf("upgrade should leave the edited README.md unchanged, got:\n%s", readme)
+	}
+	if !strings.Contains(strings.Join(gen.SkippedFiles(), "\n"), "README.md") {
+		t.Errorf("SkippedFiles() = %v, want README.md", gen.SkippedFiles())
+	}
+}
+
+func TestUpgradeProject_RefusesWithoutManifest(t *testing.T) {
+	outputDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(outputDir, "demo"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := upgradeProject(outputDir, "demo")
+	if err == nil || !strings.Contains(err.Error(), "does not look generated") {
+		t.Fatalf("upgradeProject error = %v, want a missing manifest error", err)
+	}
+}
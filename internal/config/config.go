@@ -2,23 +2,92 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"slices"
+	"strings"
+	"time"
 )
 
+// DefaultPort is the HTTP port used when none is configured.
+const DefaultPort = 8080
+
+// DefaultReadHeaderTimeout bounds how long the generated server waits for
+// request headers when none is configured, mitigating Slowloris attacks.
+const DefaultReadHeaderTimeout = 5 * time.Second
+
 type Config struct {
-	ProjectName   string
-	ModulePath    string
-	GoVersion     string
-	Framework     string
-	Databases     []string
-	Logger        string
-	EnableTracing bool
-	EnableMetrics bool
-	IncludeDocker bool
-	CI            string
-	ConfigFormat  string // "env", "json", "yaml", or "toml"
-	EnvSample     bool   // Generate sample .env file with documentation
+	ProjectName          string
+	ModulePath           string
+	GoVersion            string
+	Framework            string
+	Databases            []string
+	Logger               string
+	EnableTracing        bool
+	EnableMetrics        bool
+	IncludeDocker        bool
+	CI                   string        // Comma-separated CI systems: "github", "gitlab", or "github,gitlab"
+	ConfigFormat         string        // "env", "json", "yaml", "toml", or "hcl"
+	EnvSample            bool          // Generate sample .env file with documentation
+	DrainDelay           time.Duration // Delay between failing readiness and shutdown on SIGTERM
+	SlowRequestThreshold time.Duration // Requests slower than this are logged at warn level (0 disables)
+	Validator            bool          // Generate pkg/validate with go-playground/validator
+	Port                 int           // Default HTTP port of the generated app (0 means DefaultPort)
+	ContainerPort        int           // Port the app listens on inside the container (0 means Port)
+	Vendor               bool          // Run go mod tidy and go mod vendor after generation
+	InitGitRemote        bool          // Run git init and add an origin remote derived from ModulePath
+	Workspace            bool          // Run go work use to add the project to the go.work in or above the output directory
+	Author               string        // Copyright holder prepended to generated Go files
+	AuthorEmail          string        // Contact email included in the copyright line
+	Header               string        // License header prepended to generated Go files
+	ProbeAliases         bool          // Register /livez and /readyz aliases for Kubernetes probes
+	ConfigSchema         bool          // Generate config.schema.json for structured config formats
+	EnvPrefix            string        // Prefix prepended to every environment variable (e.g., MYAPP)
+	MetricsNamespace     string        // Prometheus namespace prepended to metric names (e.g., myapp)
+	MetricsSubsystem     string        // Prometheus subsystem between namespace and metric name
+	MetricsType          string        // "histogram" or "summary" for the request latency metric
+	MetricsObjectives    []float64     // Quantiles of the latency summary (e.g., 0.5, 0.9, 0.99)
+	AccessLogFormat      string        // "structured", "common", or "combined"
+	LogFormat            string        // "json", or "stackdriver" for Google Cloud Logging field names
+	OtelLogs             bool          // Export slog records over OTLP via the otelslog bridge
+	DBConfigStyle        string        // "url" (single connection URL) or "discrete" (host, port, user, ...)
+	DBSSLMode            string        // "disable", "require", or "verify-full" TLS for the default database URLs
+	DisableUUID          bool          // Generate request IDs with crypto/rand instead of google/uuid
+	EnableCORS           bool          // Generate CORS middleware with environment-aware allowed origins
+	OTLPHeaders          bool          // Send OTLP_HEADERS key=value pairs with every OTLP export
+	OTLPSecure           bool          // Export OTLP over TLS instead of an insecure connection
+	ExportMiddleware     bool          // Emit RequestID, Logger, and Recoverer as the importable pkg/httpmw
+	PasswordHash         string        // "bcrypt" or "argon2" generates pkg/hash; empty omits it
+	Private              bool          // Set GOPRIVATE to the module root in the Makefile and CI
+	Replaces             []string      // go.mod replace directives as old=new (e.g., github.com/foo/bar=../bar)
+	HTTP2                bool          // Serve plaintext HTTP/2 (h2c) from the stdlib or chi server
+	MaxConcurrentStreams uint32        // Default HTTP/2 streams per connection (0 keeps the x/net/http2 default of 250)
+	EnableWebSocket      bool          // Generate a /ws endpoint echoing WebSocket messages
+	ConfigValidation     string        // "lenient" or "strict" (unknown config file keys are errors)
+	HealthDetail         bool          // Generate /healthz/detailed with uptime, Go version, and build info
+	TrustedProxies       []string      // IPs or CIDRs whose X-Forwarded-For header is trusted for the client IP
+	ReadHeaderTimeout    time.Duration // Time allowed to read request headers (0 uses DefaultReadHeaderTimeout)
+	MaxHeaderBytes       int           // Maximum request header size (0 keeps the net/http default of 1 MiB)
+	MaxInflight          int           // Default cap on concurrently served requests (0 omits the limiter)
+	TraceIDHeader        string        // Response header echoing the trace ID, or request ID (e.g., X-Trace-ID)
+	Examples             bool          // Generate examples/requests.http and examples/curl.sh for every endpoint
+	Procfile             bool          // Generate a Procfile declaring the web process for Heroku/Foreman
+	VulnCheck            bool          // Run govulncheck in the generated CI pipeline and make ci
+	Signals              bool          // Cycle the log level on SIGUSR1 (Unix) through dynamic logger levels
+	LogSampling          bool          // Sample debug and info logs: per second, the first 100 then every 100th
+	SBOM                 bool          // Generate make sbom and a CI job writing an SPDX SBOM with syft
+	VSCode               bool          // Generate .vscode/launch.json and settings.json
+	Nix                  bool          // Generate flake.nix with a dev shell pinning Go, golangci-lint, and mockgen
+	EnvSchema            bool          // Generate internal/config/schema.go checking every env var at startup
+	TxHelper             bool          // Generate internal/database/tx.go with WithTx for the SQL databases
+	BuildTags            bool          // Serve pprof only from binaries built with -tags debug (cmd/<name>/pprof_debug.go)
+	DisableRecover       bool          // Recover panics only in production, letting them propagate in tests and development
+	ContentNegotiation   bool          // Respond in JSON or XML according to the Accept header (stdlib and chi)
+	NoComments           bool          // Strip explanatory comments from generated Go files and the .env/config examples
+
+	// RouteTimeouts maps exact request paths to the timeout the server
+	// applies to them instead of its 60s default (stdlib, chi, and echo)
+	RouteTimeouts map[string]time.Duration
 }
 
 // Validate checks that the configuration is valid for project generation.
@@ -52,7 +121,7 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("Go version must be one of: %v", validGoVersions)
 	}
 
-	validFrameworks := []string{"stdlib", "chi", "gin", "echo", "fiber"}
+	validFrameworks := []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"}
 	if c.Framework != "" && !slices.Contains(validFrameworks, c.Framework) {
 		return fmt.Errorf("framework must be one of: %v", validFrameworks)
 	}
@@ -62,18 +131,257 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("logger must be one of: %v", validLoggers)
 	}
 
-	validConfigFormats := []string{"", "env", "yaml", "json", "toml"}
+	validAccessLogFormats := []string{"structured", "common", "combined"}
+	if c.AccessLogFormat != "" && !slices.Contains(validAccessLogFormats, c.AccessLogFormat) {
+		return fmt.Errorf("access log format must be one of: %v", validAccessLogFormats)
+	}
+
+	validLogFormats := []string{"json", "stackdriver"}
+	if c.LogFormat != "" && !slices.Contains(validLogFormats, c.LogFormat) {
+		return fmt.Errorf("log format must be one of: %v", validLogFormats)
+	}
+	if c.LogFormat == "stackdriver" && c.Logger == "zerolog" {
+		return fmt.Errorf("stackdriver log format requires the slog or zap logger")
+	}
+
+	validDBConfigStyles := []string{"url", "discrete"}
+	if c.DBConfigStyle != "" && !slices.Contains(validDBConfigStyles, c.DBConfigStyle) {
+		return fmt.Errorf("db config style must be one of: %v", validDBConfigStyles)
+	}
+
+	validDBSSLModes := []string{"disable", "require", "verify-full"}
+	if c.DBSSLMode != "" && !slices.Contains(validDBSSLModes, c.DBSSLMode) {
+		return fmt.Errorf("db ssl mode must be one of: %v", validDBSSLModes)
+	}
+
+	validPasswordHashes := []string{"bcrypt", "argon2"}
+	if c.PasswordHash != "" && !slices.Contains(validPasswordHashes, c.PasswordHash) {
+		return fmt.Errorf("password hash must be one of: %v", validPasswordHashes)
+	}
+
+	if c.OtelLogs && (c.Logger != "slog" || !c.EnableTracing) {
+		return fmt.Errorf("otel logs require the slog logger with tracing enabled")
+	}
+
+	if (c.OTLPHeaders || c.OTLPSecure) && !c.EnableTracing {
+		return fmt.Errorf("otlp headers and otlp secure require tracing enabled")
+	}
+
+	if c.HTTP2 && c.Framework != "stdlib" && c.Framework != "chi" {
+		return fmt.Errorf("http2 requires the stdlib or chi framework")
+	}
+
+	if c.MaxConcurrentStreams > 0 && !c.HTTP2 {
+		return fmt.Errorf("max concurrent streams requires http2")
+	}
+
+	for _, proxy := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("invalid trusted proxy %q: must be an IP address or CIDR", proxy)
+		}
+	}
+
+	if len(c.TrustedProxies) > 0 && c.Framework == "fasthttp" {
+		return fmt.Errorf("trusted proxies are not supported by the fasthttp framework")
+	}
+
+	if c.ContentNegotiation && c.Framework != "stdlib" && c.Framework != "chi" {
+		return fmt.Errorf("content negotiation requires the stdlib or chi framework")
+	}
+
+	if len(c.RouteTimeouts) > 0 && c.Framework != "stdlib" && c.Framework != "chi" && c.Framework != "echo" {
+		return fmt.Errorf("timeout per route requires the stdlib, chi, or echo framework")
+	}
+	for path, timeout := range c.RouteTimeouts {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("timeout per route path %q must start with /", path)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("timeout per route for %q must be positive", path)
+		}
+	}
+
+	if c.ExportMiddleware && c.Framework != "stdlib" {
+		return fmt.Errorf("export middleware requires the stdlib framework")
+	}
+
+	if c.Port < 0 || c.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+
+	if c.ContainerPort < 0 || c.ContainerPort > 65535 {
+		return fmt.Errorf("container port must be between 1 and 65535")
+	}
+
+	if c.DrainDelay < 0 {
+		return fmt.Errorf("drain delay must not be negative")
+	}
+
+	if c.SlowRequestThreshold < 0 {
+		return fmt.Errorf("slow request threshold must not be negative")
+	}
+
+	if c.ReadHeaderTimeout < 0 {
+		return fmt.Errorf("read header timeout must not be negative")
+	}
+
+	if c.MaxHeaderBytes < 0 {
+		return fmt.Errorf("max header bytes must not be negative")
+	}
+
+	if c.MaxInflight < 0 {
+		return fmt.Errorf("max in-flight requests must not be negative")
+	}
+
+	if c.TraceIDHeader != "" && !regexp.MustCompile(`^[A-Za-z0-9-]+$`).MatchString(c.TraceIDHeader) {
+		return fmt.Errorf("trace id header must be letters, digits, and hyphens (e.g., X-Trace-ID)")
+	}
+
+	if c.TxHelper && !c.NeedsSQL() {
+		return fmt.Errorf("tx helper requires a postgres or mysql database")
+	}
+
+	for _, replace := range c.Replaces {
+		if err := validateReplace(replace); err != nil {
+			return err
+		}
+	}
+
+	if c.AuthorEmail != "" && c.Author == "" {
+		return fmt.Errorf("author email requires an author")
+	}
+
+	validDatabases := []string{"postgres", "mysql", "mongodb", "redis"}
+	for _, db := range c.Databases {
+		if !slices.Contains(validDatabases, db) {
+			return fmt.Errorf("database must be one of: %v (got %q)", validDatabases, db)
+		}
+	}
+
+	validCIs := []string{"github", "gitlab"}
+	for _, ci := range c.CISystems() {
+		if !slices.Contains(validCIs, ci) {
+			return fmt.Errorf("ci must be one of: %v (got %q)", validCIs, ci)
+		}
+	}
+
+	validConfigFormats := []string{"", "env", "yaml", "json", "toml", "hcl"}
 	if !slices.Contains(validConfigFormats, c.ConfigFormat) {
-		return fmt.Errorf("config format must be one of: env, yaml, json, toml")
+		return fmt.Errorf("config format must be one of: env, yaml, json, toml, hcl")
+	}
+
+	if c.EnvPrefix != "" && !regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`).MatchString(c.EnvPrefix) {
+		return fmt.Errorf("env prefix must be uppercase letters, digits, and underscores (e.g., MYAPP)")
+	}
+
+	metricName := regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	if c.MetricsNamespace != "" && !metricName.MatchString(c.MetricsNamespace) {
+		return fmt.Errorf("metrics namespace must be letters, digits, and underscores (e.g., myapp)")
+	}
+	if c.MetricsSubsystem != "" && !metricName.MatchString(c.MetricsSubsystem) {
+		return fmt.Errorf("metrics subsystem must be letters, digits, and underscores (e.g., api)")
+	}
+
+	validMetricsTypes := []string{"histogram", "summary"}
+	if c.MetricsType != "" && !slices.Contains(validMetricsTypes, c.MetricsType) {
+		return fmt.Errorf("metrics type must be one of: %v", validMetricsTypes)
+	}
+	if len(c.MetricsObjectives) > 0 && c.MetricsType != "summary" {
+		return fmt.Errorf("metrics objectives require the summary metrics type")
+	}
+	for _, q := range c.MetricsObjectives {
+		if q <= 0 || q >= 1 {
+			return fmt.Errorf("metrics objective %v must be a quantile between 0 and 1", q)
+		}
+	}
+
+	if c.ConfigSchema && (c.ConfigFormat == "" || c.ConfigFormat == "env" || c.ConfigFormat == "hcl") {
+		return fmt.Errorf("config schema requires a yaml, json, or toml config format")
+	}
+
+	validConfigValidations := []string{"lenient", "strict"}
+	if c.ConfigValidation != "" && !slices.Contains(validConfigValidations, c.ConfigValidation) {
+		return fmt.Errorf("config validation must be one of: %v", validConfigValidations)
+	}
+	if c.ConfigValidation == "strict" && (c.ConfigFormat == "" || c.ConfigFormat == "env") {
+		return fmt.Errorf("strict config validation requires a yaml, json, toml, or hcl config format")
+	}
+	if c.EnvSchema && c.ConfigFormat != "" && c.ConfigFormat != "env" {
+		return fmt.Errorf("env schema requires the env config format")
 	}
 
 	return nil
 }
 
+// replaceModule matches the module path, with an optional @version, of either
+// side of a go.mod replace directive.
+var replaceModule = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._~/-]*(@v[0-9][0-9A-Za-z.+-]*)?$`)
+
+// validateReplace checks that replace is old=new as go.mod accepts it: a
+// module path with an optional version replaced by a local directory, or by
+// another module path at a version.
+func validateReplace(replace string) error {
+	old, replacement, ok := strings.Cut(replace, "=")
+	if !ok || !replaceModule.MatchString(old) || replacement == "" || strings.ContainsAny(replacement, " \t=") {
+		return fmt.Errorf("replace %q must be old=new (e.g., github.com/foo/bar=../bar)", replace)
+	}
+	if IsLocalReplacement(replacement) {
+		return nil
+	}
+	if !replaceModule.MatchString(replacement) || !strings.Contains(replacement, "@") {
+		return fmt.Errorf("replace %q must name a local directory (./ or ../) or a module at a version (e.g., github.com/fork/bar@v1.2.3)", replace)
+	}
+	return nil
+}
+
+// IsLocalReplacement reports whether the new side of a replace directive is
+// a directory rather than a module.
+func IsLocalReplacement(replacement string) bool {
+	return strings.HasPrefix(replacement, "./") || strings.HasPrefix(replacement, "../") || strings.HasPrefix(replacement, "/")
+}
+
+// AppPort returns the HTTP port the generated app listens on by default.
+func (c *Config) AppPort() int {
+	if c.Port == 0 {
+		return DefaultPort
+	}
+	return c.Port
+}
+
+// AppReadHeaderTimeout returns the time the generated server allows for
+// reading request headers.
+func (c *Config) AppReadHeaderTimeout() time.Duration {
+	if c.ReadHeaderTimeout == 0 {
+		return DefaultReadHeaderTimeout
+	}
+	return c.ReadHeaderTimeout
+}
+
+// ContainerAppPort returns the port the app listens on inside its container.
+func (c *Config) ContainerAppPort() int {
+	if c.ContainerPort == 0 {
+		return c.AppPort()
+	}
+	return c.ContainerPort
+}
+
 func (c *Config) HasDatabase(db string) bool {
 	return slices.Contains(c.Databases, db)
 }
 
+// CISystems returns the CI systems listed in CI, or nil when none is set.
+func (c *Config) CISystems() []string {
+	if c.CI == "" {
+		return nil
+	}
+	return strings.Split(c.CI, ",")
+}
+
+// HasCI reports whether a pipeline is generated for the CI system ci.
+func (c *Config) HasCI(ci string) bool {
+	return slices.Contains(c.CISystems(), ci)
+}
+
 func (c *Config) NeedsCache() bool {
 	return c.HasDatabase("redis")
 }
@@ -85,3 +393,9 @@ func (c *Config) NeedsSQL() bool {
 func (c *Config) NeedsNoSQL() bool {
 	return c.HasDatabase("mongodb")
 }
+
+// DiscretePostgresConfig reports whether PostgreSQL is configured through
+// discrete host, port, user, password, and name fields instead of a URL.
+func (c *Config) DiscretePostgresConfig() bool {
+	return c.DBConfigStyle == "discrete" && c.HasDatabase("postgres")
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -106,6 +107,515 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "logger must be one of",
 		},
+		{
+			name: "invalid database",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Databases:   []string{"none"},
+			},
+			wantErr: true,
+			errMsg:  "database must be one of",
+		},
+		{
+			name: "multiple ci systems",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				CI:          "github,gitlab",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ci",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				CI:          "github,jenkins",
+			},
+			wantErr: true,
+			errMsg:  "ci must be one of",
+		},
+		{
+			name: "author email without author",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				AuthorEmail: "jane@example.com",
+			},
+			wantErr: true,
+			errMsg:  "author email requires an author",
+		},
+		{
+			name: "config schema with env format",
+			config: Config{
+				ProjectName:  "my-project",
+				ModulePath:   "github.com/user/my-project",
+				GoVersion:    "1.23",
+				ConfigFormat: "env",
+				ConfigSchema: true,
+			},
+			wantErr: true,
+			errMsg:  "config schema requires",
+		},
+		{
+			name: "hcl config format",
+			config: Config{
+				ProjectName:  "my-project",
+				ModulePath:   "github.com/user/my-project",
+				GoVersion:    "1.23",
+				ConfigFormat: "hcl",
+			},
+			wantErr: false,
+		},
+		{
+			name: "config schema with hcl format",
+			config: Config{
+				ProjectName:  "my-project",
+				ModulePath:   "github.com/user/my-project",
+				GoVersion:    "1.23",
+				ConfigFormat: "hcl",
+				ConfigSchema: true,
+			},
+			wantErr: true,
+			errMsg:  "config schema requires",
+		},
+		{
+			name: "env schema with yaml format",
+			config: Config{
+				ProjectName:  "my-project",
+				ModulePath:   "github.com/user/my-project",
+				GoVersion:    "1.23",
+				ConfigFormat: "yaml",
+				EnvSchema:    true,
+			},
+			wantErr: true,
+			errMsg:  "env schema requires the env config format",
+		},
+		{
+			name: "invalid config validation",
+			config: Config{
+				ProjectName:      "my-project",
+				ModulePath:       "github.com/user/my-project",
+				GoVersion:        "1.23",
+				ConfigFormat:     "yaml",
+				ConfigValidation: "paranoid",
+			},
+			wantErr: true,
+			errMsg:  "config validation must be one of",
+		},
+		{
+			name: "strict config validation with env format",
+			config: Config{
+				ProjectName:      "my-project",
+				ModulePath:       "github.com/user/my-project",
+				GoVersion:        "1.23",
+				ConfigFormat:     "env",
+				ConfigValidation: "strict",
+			},
+			wantErr: true,
+			errMsg:  "strict config validation requires",
+		},
+		{
+			name: "lowercase env prefix",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				EnvPrefix:   "myapp",
+			},
+			wantErr: true,
+			errMsg:  "env prefix must be uppercase",
+		},
+		{
+			name: "negative slow request threshold",
+			config: Config{
+				ProjectName:          "my-project",
+				ModulePath:           "github.com/user/my-project",
+				GoVersion:            "1.23",
+				SlowRequestThreshold: -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "slow request threshold must not be negative",
+		},
+		{
+			name: "metrics namespace with dash",
+			config: Config{
+				ProjectName:      "my-project",
+				ModulePath:       "github.com/user/my-project",
+				GoVersion:        "1.23",
+				MetricsNamespace: "my-app",
+			},
+			wantErr: true,
+			errMsg:  "metrics namespace must be letters",
+		},
+		{
+			name: "invalid access log format",
+			config: Config{
+				ProjectName:     "my-project",
+				ModulePath:      "github.com/user/my-project",
+				GoVersion:       "1.23",
+				AccessLogFormat: "apache",
+			},
+			wantErr: true,
+			errMsg:  "access log format must be one of",
+		},
+		{
+			name: "invalid password hash",
+			config: Config{
+				ProjectName:  "my-project",
+				ModulePath:   "github.com/user/my-project",
+				GoVersion:    "1.23",
+				PasswordHash: "md5",
+			},
+			wantErr: true,
+			errMsg:  "password hash must be one of",
+		},
+		{
+			name: "invalid db config style",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				DBConfigStyle: "dsn",
+			},
+			wantErr: true,
+			errMsg:  "db config style must be one of",
+		},
+		{
+			name: "invalid db ssl mode",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				DBSSLMode:   "prefer",
+			},
+			wantErr: true,
+			errMsg:  "db ssl mode must be one of",
+		},
+		{
+			name: "invalid log format",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				LogFormat:   "logfmt",
+			},
+			wantErr: true,
+			errMsg:  "log format must be one of",
+		},
+		{
+			name: "stackdriver log format with zerolog",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Logger:      "zerolog",
+				LogFormat:   "stackdriver",
+			},
+			wantErr: true,
+			errMsg:  "stackdriver log format requires the slog or zap logger",
+		},
+		{
+			name: "otel logs without tracing",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Logger:      "slog",
+				OtelLogs:    true,
+			},
+			wantErr: true,
+			errMsg:  "otel logs require the slog logger with tracing enabled",
+		},
+		{
+			name: "otlp headers without tracing",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				OTLPHeaders: true,
+			},
+			wantErr: true,
+			errMsg:  "otlp headers and otlp secure require tracing enabled",
+		},
+		{
+			name: "http2 without stdlib or chi",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Framework:   "fiber",
+				HTTP2:       true,
+			},
+			wantErr: true,
+			errMsg:  "http2 requires the stdlib or chi framework",
+		},
+		{
+			name: "max concurrent streams without http2",
+			config: Config{
+				ProjectName:          "my-project",
+				ModulePath:           "github.com/user/my-project",
+				GoVersion:            "1.23",
+				MaxConcurrentStreams: 500,
+			},
+			wantErr: true,
+			errMsg:  "max concurrent streams requires http2",
+		},
+		{
+			name: "invalid metrics type",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				MetricsType: "gauge",
+			},
+			wantErr: true,
+			errMsg:  "metrics type must be one of: [histogram summary]",
+		},
+		{
+			name: "metrics objectives without summary",
+			config: Config{
+				ProjectName:       "my-project",
+				ModulePath:        "github.com/user/my-project",
+				GoVersion:         "1.23",
+				MetricsType:       "histogram",
+				MetricsObjectives: []float64{0.9},
+			},
+			wantErr: true,
+			errMsg:  "metrics objectives require the summary metrics type",
+		},
+		{
+			name: "metrics objective out of range",
+			config: Config{
+				ProjectName:       "my-project",
+				ModulePath:        "github.com/user/my-project",
+				GoVersion:         "1.23",
+				MetricsType:       "summary",
+				MetricsObjectives: []float64{0.5, 1},
+			},
+			wantErr: true,
+			errMsg:  "metrics objective 1 must be a quantile between 0 and 1",
+		},
+		{
+			name: "content negotiation without stdlib or chi",
+			config: Config{
+				ProjectName:        "my-project",
+				ModulePath:         "github.com/user/my-project",
+				GoVersion:          "1.23",
+				Framework:          "gin",
+				ContentNegotiation: true,
+			},
+			wantErr: true,
+			errMsg:  "content negotiation requires the stdlib or chi framework",
+		},
+		{
+			name: "timeout per route without stdlib, chi, or echo",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				Framework:     "gin",
+				RouteTimeouts: map[string]time.Duration{"/reports": 30 * time.Second},
+			},
+			wantErr: true,
+			errMsg:  "timeout per route requires the stdlib, chi, or echo framework",
+		},
+		{
+			name: "timeout per route path without leading slash",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				Framework:     "chi",
+				RouteTimeouts: map[string]time.Duration{"reports": 30 * time.Second},
+			},
+			wantErr: true,
+			errMsg:  `timeout per route path "reports" must start with /`,
+		},
+		{
+			name: "zero timeout per route",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				Framework:     "stdlib",
+				RouteTimeouts: map[string]time.Duration{"/reports": 0},
+			},
+			wantErr: true,
+			errMsg:  `timeout per route for "/reports" must be positive`,
+		},
+		{
+			name: "replace without new path",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Replaces:    []string{"github.com/foo/bar"},
+			},
+			wantErr: true,
+			errMsg:  `replace "github.com/foo/bar" must be old=new (e.g., github.com/foo/bar=../bar)`,
+		},
+		{
+			name: "replace with unversioned module",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Replaces:    []string{"github.com/foo/bar=github.com/fork/bar"},
+			},
+			wantErr: true,
+			errMsg:  `replace "github.com/foo/bar=github.com/fork/bar" must name a local directory (./ or ../) or a module at a version (e.g., github.com/fork/bar@v1.2.3)`,
+		},
+		{
+			name: "valid replaces",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Replaces:    []string{"github.com/foo/bar=../bar", "github.com/foo/baz@v1.0.0=github.com/fork/baz@v1.0.1"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative read header timeout",
+			config: Config{
+				ProjectName:       "my-project",
+				ModulePath:        "github.com/user/my-project",
+				GoVersion:         "1.23",
+				ReadHeaderTimeout: -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "read header timeout must not be negative",
+		},
+		{
+			name: "negative max header bytes",
+			config: Config{
+				ProjectName:    "my-project",
+				ModulePath:     "github.com/user/my-project",
+				GoVersion:      "1.23",
+				MaxHeaderBytes: -1,
+			},
+			wantErr: true,
+			errMsg:  "max header bytes must not be negative",
+		},
+		{
+			name: "negative max in-flight requests",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				MaxInflight: -1,
+			},
+			wantErr: true,
+			errMsg:  "max in-flight requests must not be negative",
+		},
+		{
+			name: "valid trace id header",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				TraceIDHeader: "X-Trace-ID",
+			},
+			wantErr: false,
+		},
+		{
+			name: "trace id header with invalid characters",
+			config: Config{
+				ProjectName:   "my-project",
+				ModulePath:    "github.com/user/my-project",
+				GoVersion:     "1.23",
+				TraceIDHeader: "X-Trace ID:",
+			},
+			wantErr: true,
+			errMsg:  "trace id header must be letters, digits, and hyphens (e.g., X-Trace-ID)",
+		},
+		{
+			name: "tx helper with mysql",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Databases:   []string{"mysql"},
+				TxHelper:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "tx helper without a sql database",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Databases:   []string{"mongodb", "redis"},
+				TxHelper:    true,
+			},
+			wantErr: true,
+			errMsg:  "tx helper requires a postgres or mysql database",
+		},
+		{
+			name: "trusted proxies with IPs and CIDRs",
+			config: Config{
+				ProjectName:    "my-project",
+				ModulePath:     "github.com/user/my-project",
+				GoVersion:      "1.23",
+				Framework:      "gin",
+				TrustedProxies: []string{"10.0.0.0/8", "192.168.1.10", "fd00::/8"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid trusted proxy",
+			config: Config{
+				ProjectName:    "my-project",
+				ModulePath:     "github.com/user/my-project",
+				GoVersion:      "1.23",
+				Framework:      "gin",
+				TrustedProxies: []string{"10.0.0.0/33"},
+			},
+			wantErr: true,
+			errMsg:  `invalid trusted proxy "10.0.0.0/33": must be an IP address or CIDR`,
+		},
+		{
+			name: "trusted proxies with fasthttp",
+			config: Config{
+				ProjectName:    "my-project",
+				ModulePath:     "github.com/user/my-project",
+				GoVersion:      "1.23",
+				Framework:      "fasthttp",
+				TrustedProxies: []string{"10.0.0.0/8"},
+			},
+			wantErr: true,
+			errMsg:  "trusted proxies are not supported by the fasthttp framework",
+		},
+		{
+			name: "export middleware without stdlib",
+			config: Config{
+				ProjectName:      "my-project",
+				ModulePath:       "github.com/user/my-project",
+				GoVersion:        "1.23",
+				Framework:        "gin",
+				ExportMiddleware: true,
+			},
+			wantErr: true,
+			errMsg:  "export middleware requires the stdlib framework",
+		},
+		{
+			name: "port out of range",
+			config: Config{
+				ProjectName: "my-project",
+				ModulePath:  "github.com/user/my-project",
+				GoVersion:   "1.23",
+				Port:        70000,
+			},
+			wantErr: true,
+			errMsg:  "port must be between 1 and 65535",
+		},
 		{
 			name: "project name with underscore is valid",
 			config: Config{
@@ -133,6 +643,30 @@ func TestConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestConfig_Ports(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        Config
+		wantPort      int
+		wantContainer int
+	}{
+		{"defaults", Config{}, DefaultPort, DefaultPort},
+		{"custom port", Config{Port: 9000}, 9000, 9000},
+		{"distinct container port", Config{Port: 9000, ContainerPort: 8080}, 9000, 8080},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.AppPort(); got != tt.wantPort {
+				t.Errorf("AppPort() = %d, want %d", got, tt.wantPort)
+			}
+			if got := tt.config.ContainerAppPort(); got != tt.wantContainer {
+				t.Errorf("ContainerAppPort() = %d, want %d", got, tt.wantContainer)
+			}
+		})
+	}
+}
+
 func TestConfig_HasDatabase(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -170,6 +704,43 @@ func TestConfig_HasDatabase(t *testing.T) {
 	}
 }
 
+func TestConfig_HasCI(t *testing.T) {
+	tests := []struct {
+		name  string
+		ci    string
+		check string
+		want  bool
+	}{
+		{
+			name:  "single ci",
+			ci:    "gitlab",
+			check: "gitlab",
+			want:  true,
+		},
+		{
+			name:  "both ci systems",
+			ci:    "github,gitlab",
+			check: "github",
+			want:  true,
+		},
+		{
+			name:  "no ci",
+			ci:    "",
+			check: "github",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{CI: tt.ci}
+			if got := c.HasCI(tt.check); got != tt.want {
+				t.Errorf("Config.HasCI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConfig_NeedsCache(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getPprofFile returns the path of the pprof file, compiled into the binary
+// only with the debug build tag, or "" without --build-tags.
+func (g *Generator) getPprofFile() string {
+	if !g.config.BuildTags {
+		return ""
+	}
+	return fmt.Sprintf("cmd/%s/pprof_debug.go", g.config.ProjectName)
+}
+
+// generatePprofFile writes cmd/<name>/pprof_debug.go, serving the pprof
+// endpoints on a listener of their own in binaries built with -tags debug.
+// Release builds leave the file out, so profiling can't be switched on in
+// production by a stray flag or environment variable.
+func (g *Generator) generatePprofFile() error {
+	path := g.getPprofFile()
+	if path == "" {
+		return nil
+	}
+
+	content := fmt.Sprintf(`//go:build debug
+
+// Built only with the debug tag (make build-debug or go build -tags debug):
+// pprof exposes the internals of the process, so release builds leave it out.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+)
+
+// init serves the pprof endpoints on %[1]s (localhost:6060 by default),
+// apart from the application routes.
+func init() {
+	addr := os.Getenv(%[1]q)
+	if addr == "" {
+		addr = "localhost:6060"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			fmt.Fprintf(os.Stderr, "pprof server stopped: %%v\n", err)
+		}
+	}()
+}
+`, g.getPprofAddrEnv())
+
+	return g.writeFile(path, content)
+}
+
+// getPprofAddrEnv returns the env var setting the pprof address of debug
+// builds, or "" without --build-tags.
+func (g *Generator) getPprofAddrEnv() string {
+	if !g.config.BuildTags {
+		return ""
+	}
+	return g.envVar("PPROF_ADDR")
+}
@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_BuildTagsPprof(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.BuildTags = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	pprof := mfs.FileContent("/output/test-project/cmd/test-project/pprof_debug.go")
+	if !strings.HasPrefix(pprof, "//go:build debug\n") {
+		t.Error("pprof_debug.go should start with the debug build constraint")
+	}
+	for _, want := range []string{
+		`mux.HandleFunc("/debug/pprof/", pprof.Index)`,
+		`addr := os.Getenv("PPROF_ADDR")`,
+	} {
+		if !strings.Contains(pprof, want) {
+			t.Errorf("pprof_debug.go should contain %q", want)
+		}
+	}
+
+	main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	if strings.Contains(main, "pprof") {
+		t.Error("main.go should leave pprof to the build-tagged file")
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	if !strings.Contains(makefile, "go build -tags debug -o bin/$(BINARY_NAME)-debug $(MAIN_PATH)") {
+		t.Error("Makefile should have a build-debug target building with -tags debug")
+	}
+}
+
+func TestGenerator_BuildTagsDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/cmd/test-project/pprof_debug.go") {
+		t.Error("pprof_debug.go should not be generated without --build-tags")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "build-debug") {
+		t.Error("Makefile should not have a build-debug target without --build-tags")
+	}
+}
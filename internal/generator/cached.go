@@ -0,0 +1,131 @@
+package generator
+
+import "fmt"
+
+// getCachedImports returns the handlers.go imports of the /cached example,
+// or nil without a cache.
+func (g *Generator) getCachedImports() []string {
+	if !g.config.NeedsCache() {
+		return nil
+	}
+	return []string{`"context"`, `"errors"`, `"time"`, fmt.Sprintf(`"%s/internal/cache"`, g.config.ModulePath)}
+}
+
+// getCachedHandlerField returns the Handler field holding the cache of the
+// /cached example, or "" without a cache.
+func (g *Generator) getCachedHandlerField() string {
+	if !g.config.NeedsCache() {
+		return ""
+	}
+	return "\tcache  atomic.Pointer[cache.RedisCache] // Set by server.New from WithCache\n"
+}
+
+// getCachedHandlers returns the /cached handler demonstrating the cache-aside
+// pattern for the configured framework, or "" without a cache.
+func (g *Generator) getCachedHandlers() string {
+	if !g.config.NeedsCache() {
+		return ""
+	}
+
+	write := `	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)`
+	if g.config.ContentNegotiation {
+		write = "\trespond(w, r, status, response)"
+	}
+
+	handler := `
+// cachedKey is the cache key of the /cached example value.
+const cachedKey = "example:cached"
+
+// cachedTTL is how long /cached serves the value from the cache before
+// computing it again.
+const cachedTTL = 30 * time.Second
+
+// SetCache hands the connected cache to the /cached example. Without one,
+// /cached answers 503.
+func (h *Handler) SetCache(c *cache.RedisCache) {
+	h.cache.Store(c)
+}
+
+// cacheAside reads the /cached value through the cache: on a miss it
+// computes the value and caches it for cachedTTL.
+func (h *Handler) cacheAside(ctx context.Context) (int, Response) {
+	c := h.cache.Load()
+	if c == nil {
+		return http.StatusServiceUnavailable, notReadyResponse
+	}
+
+	source := "cache"
+	value, err := c.Get(ctx, cachedKey)
+	if errors.Is(err, cache.ErrMiss) {
+		// Stands in for an expensive computation, e.g. a database query
+		value = time.Now().UTC().Format(time.RFC3339)
+		source = "computed"
+		err = c.Set(ctx, cachedKey, value, cachedTTL)
+	}
+	if err != nil {
+		return http.StatusInternalServerError, Response{Status: "error", Message: "cache unavailable"}
+	}
+	return http.StatusOK, Response{
+		Status: "ok",
+		Data:   map[string]interface{}{"value": value, "source": source},
+	}
+}
+
+// Cached serves a value read through the cache (cache-aside). The source
+// field tells whether it came from the cache or was computed.
+func (h *Handler) Cached(w http.ResponseWriter, r *http.Request) {
+	status, response := h.cacheAside(r.Context())
+` + write + `
+}
+`
+	switch g.config.Framework {
+	case "gin":
+		handler += `
+func (h *Handler) CachedGin(c *gin.Context) {
+	h.Cached(c.Writer, c.Request)
+}
+`
+	case "echo":
+		handler += `
+func (h *Handler) CachedEcho(c echo.Context) error {
+	h.Cached(c.Response(), c.Request())
+	return nil
+}
+`
+	case "fiber":
+		handler += `
+func (h *Handler) CachedFiber(c *fiber.Ctx) error {
+	status, response := h.cacheAside(c.UserContext())
+	return c.Status(status).JSON(response)
+}
+`
+	case "fasthttp":
+		handler += `
+func (h *Handler) CachedFastHTTP(ctx *fasthttp.RequestCtx) {
+	status, response := h.cacheAside(ctx)
+	writeFastHTTPJSON(ctx, status, response)
+}
+`
+	}
+	return handler
+}
+
+// getCachedHandlerTests returns the generated handler tests of the /cached
+// example, or "" without a cache.
+func (g *Generator) getCachedHandlerTests() string {
+	if !g.config.NeedsCache() {
+		return ""
+	}
+	return `
+func (suite *HandlerTestSuite) TestCachedBeforeCacheConnected() {
+	req := httptest.NewRequest(http.MethodGet, "/cached", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.Cached(w, req)
+
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+}
+`
+}
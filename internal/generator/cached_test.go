@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_CachedExample(t *testing.T) {
+	tests := []struct {
+		framework string
+		route     string
+	}{
+		{"stdlib", `mux.HandleFunc("/cached", handler.Cached)`},
+		{"chi", `r.Get("/cached", handler.Cached)`},
+		{"gin", `r.GET("/cached", handler.CachedGin)`},
+		{"echo", `s.echo.GET("/cached", handler.CachedEcho)`},
+		{"fiber", `s.app.Get("/cached", handler.CachedFiber)`},
+		{"fasthttp", `"/cached":`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.Databases = []string{"redis"}
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.route) {
+				t.Errorf("server.go should register the /cached route with %q", tt.route)
+			}
+			if !strings.Contains(server, "func WithCache(c *cache.RedisCache) Option {") {
+				t.Error("server.go should let main hand over the connected cache")
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			for _, want := range []string{
+				"value, err := c.Get(ctx, cachedKey)",
+				"errors.Is(err, cache.ErrMiss)",
+				"err = c.Set(ctx, cachedKey, value, cachedTTL)",
+			} {
+				if !strings.Contains(handlers, want) {
+					t.Errorf("handlers.go should read through the cache with %q", want)
+				}
+			}
+
+			redis := mfs.FileContent("/output/test-project/internal/cache/redis.go")
+			for _, want := range []string{
+				"func (c *RedisCache) Get(ctx context.Context, key string) (string, error) {",
+				"func (c *RedisCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {",
+			} {
+				if !strings.Contains(redis, want) {
+					t.Errorf("redis.go should contain %q", want)
+				}
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/cmd/test-project/main.go"), "server.WithCache(redisCache)") {
+				t.Error("main.go should hand the connected cache to the server")
+			}
+		})
+	}
+}
+
+func TestGenerator_CachedExampleDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "/cached") {
+		t.Error("server.go should not register /cached without a cache")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/handlers/handlers.go"), "cache") {
+		t.Error("handlers.go should not reference the cache package without a cache")
+	}
+}
@@ -1,16 +1,38 @@
 package generator
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func (g *Generator) generateCIFiles() error {
-	switch g.config.CI {
-	case "github":
-		return g.generateGitHubActions()
-	case "gitlab":
-		return g.generateGitLabCI()
-	default:
-		return nil
+	for _, ci := range g.config.CISystems() {
+		var err error
+		switch ci {
+		case "github":
+			err = g.generateGitHubActions()
+		case "gitlab":
+			err = g.generateGitLabCI()
+		}
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// getCIConfigPaths returns the paths of the generated CI pipelines.
+func (g *Generator) getCIConfigPaths() []string {
+	var paths []string
+	for _, ci := range g.config.CISystems() {
+		switch ci {
+		case "github":
+			paths = append(paths, ".github/workflows/ci.yml")
+		case "gitlab":
+			paths = append(paths, ".gitlab-ci.yml")
+		}
+	}
+	return paths
 }
 
 func (g *Generator) generateGitHubActions() error {
@@ -74,11 +96,11 @@ jobs:
         uses: golangci/golangci-lint-action@v3
         with:
           version: latest
-
+%s
   build:
     name: Build
     runs-on: ubuntu-latest
-    needs: [test, lint]
+    needs: [%s]
     
     steps:
       - name: Checkout code
@@ -91,11 +113,71 @@ jobs:
 
       - name: Build
         run: go build -v ./cmd/%s
-`, g.getGitHubServicesConfig(), g.config.GoVersion, g.config.GoVersion, g.config.GoVersion, g.config.ProjectName)
+%s`, g.getGitHubServicesConfig(), g.config.GoVersion, g.config.GoVersion, g.getGitHubVulnJob(), g.getGitHubBuildNeeds(), g.config.GoVersion, g.config.ProjectName, g.getGitHubSBOMJob())
+
+	// Every job downloads modules, so each sets GOPRIVATE right after checkout
+	checkout := "        uses: actions/checkout@v4\n"
+	content = strings.ReplaceAll(content, checkout, checkout+g.getGitHubPrivateModulesStep())
 
 	return g.writeFile(".github/workflows/ci.yml", content)
 }
 
+// getGitHubVulnJob returns the GitHub Actions job running govulncheck at the
+// version pinned in go.mod, or "" unless --vuln-check is set.
+func (g *Generator) getGitHubVulnJob() string {
+	if !g.config.VulnCheck {
+		return ""
+	}
+	return fmt.Sprintf(`
+  vuln:
+    name: Vulnerability Check
+    runs-on: ubuntu-latest
+    
+    steps:
+      - name: Checkout code
+        uses: actions/checkout@v4
+
+      - name: Set up Go
+        uses: actions/setup-go@v5
+        with:
+          go-version: '%s'
+
+      - name: Run govulncheck
+        run: go run golang.org/x/vuln/cmd/govulncheck ./...
+`, g.config.GoVersion)
+}
+
+// getGitHubSBOMJob returns the GitHub Actions job writing an SPDX SBOM with
+// syft and uploading it as a workflow artifact, or "" unless --sbom is set.
+func (g *Generator) getGitHubSBOMJob() string {
+	if !g.config.SBOM {
+		return ""
+	}
+	return `
+  sbom:
+    name: SBOM
+    runs-on: ubuntu-latest
+    
+    steps:
+      - name: Checkout code
+        uses: actions/checkout@v4
+
+      - name: Generate SBOM
+        uses: anchore/sbom-action@v0
+        with:
+          format: spdx-json
+          output-file: sbom.spdx.json
+`
+}
+
+// getGitHubBuildNeeds returns the jobs the GitHub Actions build waits for.
+func (g *Generator) getGitHubBuildNeeds() string {
+	if g.config.VulnCheck {
+		return "test, lint, vuln"
+	}
+	return "test, lint"
+}
+
 func (g *Generator) getGitHubServicesConfig() string {
 	if !g.config.HasDatabase("postgres") && !g.config.HasDatabase("mysql") && !g.config.HasDatabase("mongodb") && !g.config.HasDatabase("redis") {
 		return ""
@@ -166,7 +248,7 @@ func (g *Generator) generateGitLabCI() error {
   - build
 
 variables:
-  GO_VERSION: "%s"
+  GO_VERSION: "%s"%s
 
 test:
   stage: test
@@ -197,7 +279,7 @@ lint:
   
   script:
     - golangci-lint run
-
+%s
 build:
   stage: build
   image: golang:${GO_VERSION}
@@ -214,11 +296,49 @@ build:
   artifacts:
     paths:
       - %s
-`, g.config.GoVersion, g.getGitLabServicesConfig(), g.config.ProjectName, g.config.ProjectName)
+%s`, g.config.GoVersion, g.getGitLabPrivateModulesVariable(), g.getGitLabServicesConfig(), g.getGitLabVulnJob(), g.config.ProjectName, g.config.ProjectName, g.getGitLabSBOMJob())
 
 	return g.writeFile(".gitlab-ci.yml", content)
 }
 
+// getGitLabVulnJob returns the GitLab CI job running govulncheck at the
+// version pinned in go.mod, or "" unless --vuln-check is set.
+func (g *Generator) getGitLabVulnJob() string {
+	if !g.config.VulnCheck {
+		return ""
+	}
+	return `
+vuln:
+  stage: test
+  image: golang:${GO_VERSION}
+  
+  script:
+    - go run golang.org/x/vuln/cmd/govulncheck ./...
+`
+}
+
+// getGitLabSBOMJob returns the GitLab CI job writing an SPDX SBOM with syft
+// as a job artifact, or "" unless --sbom is set.
+func (g *Generator) getGitLabSBOMJob() string {
+	if !g.config.SBOM {
+		return ""
+	}
+	return `
+sbom:
+  stage: build
+  image:
+    name: anchore/syft:latest
+    entrypoint: [""]
+  
+  script:
+    - /syft . -o spdx-json=sbom.spdx.json
+  
+  artifacts:
+    paths:
+      - sbom.spdx.json
+`
+}
+
 func (g *Generator) getGitLabServicesConfig() string {
 	if !g.config.HasDatabase("postgres") && !g.config.HasDatabase("mysql") && !g.config.HasDatabase("mongodb") && !g.config.HasDatabase("redis") {
 		return ""
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MultipleCI(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.CI = "github,gitlab"
+	cfg.IncludeDocker = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, path := range []string{".github/workflows/ci.yml", ".gitlab-ci.yml"} {
+		if !mfs.HasFile("/output/test-project/" + path) {
+			t.Errorf("%s should be generated", path)
+		}
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "# Run the same checks as .github/workflows/ci.yml and .gitlab-ci.yml,") {
+		t.Error("the Makefile ci target should name both pipelines")
+	}
+
+	dockerignore := mfs.FileContent("/output/test-project/.dockerignore")
+	if !strings.Contains(dockerignore, "# CI\n.github/\n.gitlab-ci.yml\n") {
+		t.Error(".dockerignore should exclude both pipelines")
+	}
+}
+
+func TestGenerator_NoCI(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, path := range []string{".github/workflows/ci.yml", ".gitlab-ci.yml"} {
+		if mfs.HasFile("/output/test-project/" + path) {
+			t.Errorf("%s should not be generated without --ci", path)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_CloseTests(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres", "redis"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	postgres := mfs.FileContent("/output/test-project/internal/database/postgres_close_test.go")
+	if !strings.Contains(postgres, "func TestPostgresDB_CloseWithoutPool(t *testing.T) {") {
+		t.Error("postgres_close_test.go should test Close on a zero-value PostgresDB")
+	}
+	if !strings.Contains(postgres, "func TestPostgresDB_CloseIsIdempotent(t *testing.T) {") {
+		t.Error("postgres_close_test.go should test closing the pool twice")
+	}
+
+	redis := mfs.FileContent("/output/test-project/internal/cache/redis_close_test.go")
+	if !strings.Contains(redis, "func TestRedisCache_CloseWithoutClient(t *testing.T) {") {
+		t.Error("redis_close_test.go should test Close on a zero-value RedisCache")
+	}
+}
+
+func TestGenerator_CloseTestsWithoutDatabase(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"mysql"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/database/postgres_close_test.go") {
+		t.Error("postgres_close_test.go should only be generated with PostgreSQL")
+	}
+	if mfs.HasFile("/output/test-project/internal/cache/redis_close_test.go") {
+		t.Error("redis_close_test.go should only be generated with Redis")
+	}
+}
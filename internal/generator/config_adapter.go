@@ -6,7 +6,7 @@ import (
 
 // Config accessor methods generation
 // This file generates helper methods for the Config struct to provide
-// a consistent API regardless of the config format (env, yaml, json, toml)
+// a consistent API regardless of the config format (env, yaml, json, toml, hcl)
 
 func (g *Generator) generateConfigAccessors() string {
 	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
@@ -16,11 +16,41 @@ func (g *Generator) generateConfigAccessors() string {
 
 	var sb strings.Builder
 
-	// Port accessor - handles int to string conversion
+	// Derived values are computed once in Load, as accessors such as
+	// GetSlowRequestThreshold are called on every request
 	sb.WriteString(`
+// derivedConfig holds the values derived from the loaded config, so that
+// accessors neither reformat nor reparse them on every call.
+type derivedConfig struct {
+	port                 string
+	drainDelay           time.Duration
+	slowRequestThreshold time.Duration
+}
+
+// derive computes the derived values of c. Load caches them in c.derived
+// once the environment overrides are applied.
+func (c *Config) derive() *derivedConfig {
+	drainDelay, _ := time.ParseDuration(c.App.DrainDelay)
+	slowRequestThreshold, _ := time.ParseDuration(c.App.SlowRequestThreshold)
+	return &derivedConfig{
+		port:                 fmt.Sprintf("%d", c.App.Port),
+		drainDelay:           drainDelay,
+		slowRequestThreshold: slowRequestThreshold,
+	}
+}
+
+// cached returns the derived values cached by Load, deriving them afresh
+// for configs built without Load, e.g. in tests.
+func (c *Config) cached() *derivedConfig {
+	if c.derived == nil {
+		return c.derive()
+	}
+	return c.derived
+}
+
 // GetPort returns the port as a string
 func (c *Config) GetPort() string {
-	return fmt.Sprintf("%d", c.App.Port)
+	return c.cached().port
 }
 
 // GetEnvironment returns the application environment
@@ -32,10 +62,26 @@ func (c *Config) GetEnvironment() string {
 func (c *Config) GetLogLevel() string {
 	return c.App.LogLevel
 }
+
+// GetDrainDelay returns how long to wait after failing readiness before shutdown
+func (c *Config) GetDrainDelay() time.Duration {
+	return c.cached().drainDelay
+}
+
+// GetSlowRequestThreshold returns the duration above which requests are logged at warn level
+func (c *Config) GetSlowRequestThreshold() time.Duration {
+	return c.cached().slowRequestThreshold
+}
+
+// Feature reports whether the named feature flag is enabled.
+// Unknown flags are disabled.
+func (c *Config) Feature(name string) bool {
+	return c.Features[name]
+}
 `)
 
 	// Database accessors
-	if g.config.HasDatabase("postgres") {
+	if g.config.HasDatabase("postgres") && !g.config.DiscretePostgresConfig() {
 		sb.WriteString(`
 // GetPostgresURL returns the PostgreSQL connection URL
 func (c *Config) GetPostgresURL() string {
@@ -77,6 +123,10 @@ func (c *Config) GetRedisURL() string {
 `)
 	}
 
+	sb.WriteString(g.getTimeoutAccessors())
+	sb.WriteString(g.getMaxInflightAccessor())
+	sb.WriteString(g.getMaxConcurrentStreamsAccessor())
+
 	// Observability accessors
 	if g.config.EnableTracing {
 		sb.WriteString(`
@@ -95,6 +145,14 @@ func (c *Config) IsTracingEnabled() bool {
 	return c.Observability.Tracing.Enabled
 }
 `)
+		if g.config.OTLPHeaders {
+			sb.WriteString(`
+// GetOTLPHeaders returns the headers sent with every OTLP export
+func (c *Config) GetOTLPHeaders() map[string]string {
+	return c.Observability.Tracing.Headers
+}
+`)
+		}
 	}
 
 	if g.config.EnableMetrics {
@@ -111,17 +169,31 @@ func (c *Config) GetMetricsPath() string {
 `)
 	}
 
+	if g.config.EnableCORS {
+		sb.WriteString(`
+// GetCORSAllowedOrigins returns the origins allowed to make cross-origin requests
+func (c *Config) GetCORSAllowedOrigins() []string {
+	return c.Security.CORS.AllowedOrigins
+}
+`)
+	}
+
 	return sb.String()
 }
 
 // getConfigFieldReference returns the correct way to reference a config field
 // based on the config format being used
 func (g *Generator) getConfigFieldReference(field string) string {
+	if field == "PostgresURL" && g.config.DiscretePostgresConfig() {
+		// Discrete settings are assembled into a URL by the generated builder
+		return "cfg.PostgresDSN()"
+	}
+
 	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
 		return "cfg." + field
 	}
 
-	// For structured configs (YAML, JSON, TOML), use accessor methods
+	// For structured configs (YAML, JSON, TOML, HCL), use accessor methods
 	switch field {
 	case "Port":
 		return "cfg.GetPort()"
@@ -129,6 +201,14 @@ func (g *Generator) getConfigFieldReference(field string) string {
 		return "cfg.GetEnvironment()"
 	case "LogLevel":
 		return "cfg.GetLogLevel()"
+	case "DrainDelay":
+		return "cfg.GetDrainDelay()"
+	case "SlowRequestThreshold":
+		return "cfg.GetSlowRequestThreshold()"
+	case "MaxInflight":
+		return "cfg.GetMaxInflight()"
+	case "HTTP2MaxConcurrentStreams":
+		return "cfg.GetHTTP2MaxConcurrentStreams()"
 	case "PostgresURL":
 		return "cfg.GetPostgresURL()"
 	case "MySQLURL":
@@ -137,10 +217,20 @@ func (g *Generator) getConfigFieldReference(field string) string {
 		return "cfg.GetMongoURL()"
 	case "RedisURL":
 		return "cfg.GetRedisURL()"
+	case "DBTimeout":
+		return "cfg.GetDBTimeout()"
+	case "CacheTimeout":
+		return "cfg.GetCacheTimeout()"
 	case "OTLPEndpoint":
 		return "cfg.GetOTLPEndpoint()"
 	case "ServiceName":
 		return "cfg.GetServiceName()"
+	case "OTLPHeaders":
+		return "cfg.GetOTLPHeaders()"
+	case "MetricsEnabled":
+		return "cfg.IsMetricsEnabled()"
+	case "CORSAllowedOrigins":
+		return "cfg.GetCORSAllowedOrigins()"
 	default:
 		return "cfg." + field
 	}
@@ -0,0 +1,35 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ConfigAccessorsCached(t *testing.T) {
+	for _, format := range []string{"yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			for _, check := range []string{
+				"func (c *Config) GetPort() string {\n\treturn c.cached().port\n}",
+				"func (c *Config) GetSlowRequestThreshold() time.Duration {\n\treturn c.cached().slowRequestThreshold\n}",
+				"\tcfg.applyEnvOverrides()\n\tcfg.derived = cfg.derive()\n",
+				"\t\tcfg := loadFromEnv()\n\t\tcfg.derived = cfg.derive()\n",
+			} {
+				if !strings.Contains(config, check) {
+					t.Errorf("config.go should contain %q", check)
+				}
+			}
+			if strings.Contains(config, "func (c *Config) GetPort() string {\n\treturn fmt.Sprintf") {
+				t.Error("GetPort should return the cached port instead of formatting it on every call")
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// postgresDiscreteFields lists the discrete PostgreSQL settings as
+// {env var, env-format field, structured field, structured key, default}.
+var postgresDiscreteFields = [][5]string{
+	{"DB_HOST", "DBHost", "Host", "host", "localhost"},
+	{"DB_PORT", "DBPort", "Port", "port", "5432"},
+	{"DB_USER", "DBUser", "User", "user", "user"},
+	{"DB_PASSWORD", "DBPassword", "Password", "password", "password"},
+	{"DB_NAME", "DBName", "Name", "name", "dbname"},
+	{"DB_SSLMODE", "DBSSLMode", "SSLMode", "sslmode", "disable"},
+}
+
+// generateConfigDSNFile writes internal/config/dsn.go with the PostgresDSN
+// builder when PostgreSQL uses the discrete config style.
+func (g *Generator) generateConfigDSNFile() error {
+	if !g.config.DiscretePostgresConfig() {
+		return nil
+	}
+
+	imports := []string{`"net"`, `"net/url"`}
+	body := ""
+	user, password, host, port, name, sslMode := "c.DBUser", "c.DBPassword", "c.DBHost", "c.DBPort", "c.DBName", "c.DBSSLMode"
+	if g.config.ConfigFormat != "" && g.config.ConfigFormat != "env" {
+		// Structured formats keep the settings in the postgres section, with an int port
+		imports = append(imports, `"strconv"`)
+		body = "\tp := c.Database.Postgres\n"
+		user, password, host, port, name, sslMode = "p.User", "p.Password", "p.Host", "strconv.Itoa(p.Port)", "p.Name", "p.SSLMode"
+	}
+
+	content := fmt.Sprintf(`package config
+
+import (
+	%s
+)
+
+// PostgresDSN assembles the PostgreSQL connection URL from the discrete
+// host, port, user, password, and database settings, escaping credentials.
+func (c *Config) PostgresDSN() string {
+%s	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(%s, %s),
+		Host:     net.JoinHostPort(%s, %s),
+		Path:     "/" + %s,
+		RawQuery: url.Values{"sslmode": {%s}}.Encode(),
+	}
+	return dsn.String()
+}
+`, strings.Join(imports, "\n\t"), body, user, password, host, port, name, sslMode)
+
+	return g.writeFile("internal/config/dsn.go", content)
+}
+
+// getPostgresConnectionFields returns the PostgresConfig fields holding the
+// connection settings, tagged for the given format: a single URL, or the
+// discrete fields. typeWidth aligns them with the remaining struct fields.
+func (g *Generator) getPostgresConnectionFields(tag string, typeWidth int) string {
+	field := func(name, typ, key string) string {
+		return fmt.Sprintf("\t%-14s %-*s %s\n", name, typeWidth, typ, fieldTag(tag, key))
+	}
+
+	if !g.config.DiscretePostgresConfig() {
+		return field("URL", "string", "url")
+	}
+
+	var sb strings.Builder
+	for _, f := range postgresDiscreteFields {
+		typ := "string"
+		if f[2] == "Port" {
+			typ = "int"
+		}
+		sb.WriteString(field(f[2], typ, f[3]))
+	}
+	return sb.String()
+}
+
+// getPostgresEnvOverrides returns the applyEnvOverrides statements letting
+// DB_* environment variables override the discrete PostgreSQL settings.
+func (g *Generator) getPostgresEnvOverrides() string {
+	if !g.config.DiscretePostgresConfig() {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, f := range postgresDiscreteFields {
+		if f[2] == "Port" {
+			sb.WriteString(fmt.Sprintf(`
+	if port := os.Getenv("%s"); port != "" {
+		fmt.Sscanf(port, "%%d", &c.Database.Postgres.Port)
+	}`, g.envVar(f[0])))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf(`
+	if value := os.Getenv("%s"); value != "" {
+		c.Database.Postgres.%s = value
+	}`, g.envVar(f[0]), f[2]))
+	}
+	return sb.String()
+}
+
+// getPostgresExampleSettings returns the discrete PostgreSQL settings of the
+// config examples as {key, example value} pairs. With quote, string values are
+// quoted for JSON, TOML, and HCL while the port stays a number.
+func (g *Generator) getPostgresExampleSettings(quote bool) [][2]string {
+	settings := make([][2]string, 0, len(postgresDiscreteFields))
+	for _, f := range postgresDiscreteFields {
+		value := g.postgresDefault(f)
+		if quote && f[3] != "port" {
+			value = fmt.Sprintf("%q", value)
+		}
+		settings = append(settings, [2]string{f[3], value})
+	}
+	return settings
+}
@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// generateConfigEnvFallback writes internal/config/env.go for the structured
+// config formats. Its loadFromEnv builds the config from environment variables
+// when the config file is absent, using the same defaults as the env format.
+func (g *Generator) generateConfigEnvFallback() error {
+	imports := []string{`"os"`, `"strconv"`, `"strings"`}
+	if g.config.NeedsSQL() && !g.maxIdleTimeIsString() {
+		imports = append(imports, `"time"`)
+	}
+
+	helpers := `
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+`
+	helpers += g.getFeaturesFromEnvFunc() + g.getEnvListFunc() + g.getEnvMapFunc()
+	if g.config.EnableMetrics {
+		helpers += `
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+`
+	}
+
+	content := fmt.Sprintf(`package config
+
+import (
+	%s
+)
+
+// loadFromEnv builds the configuration from environment variables alone.
+// Load falls back to it when the config file does not exist, so the same
+// binary works in both file-driven and env-driven deployments.
+func loadFromEnv() *Config {
+	cfg := &Config{
+		App: AppConfig{
+			Name:                 %q,
+			Environment:          "development",
+			Port:                 %d,
+			LogLevel:             getEnv(%q, "info"),
+			DrainDelay:           %q,
+			SlowRequestThreshold: %q,%s
+		},
+	}
+%s
+	cfg.applyEnvOverrides()
+	return cfg
+}
+%s`, strings.Join(imports, "\n\t"), g.config.ProjectName, g.config.AppPort(), g.envVar("LOG_LEVEL"),
+		g.config.DrainDelay.String(), g.config.SlowRequestThreshold.String(), g.getMaxInflightEnvFallback()+g.getMaxConcurrentStreamsEnvFallback(), g.getEnvFallbackStatements(), helpers)
+
+	return g.writeFile("internal/config/env.go", content)
+}
+
+// maxIdleTimeIsString reports whether the structured loader keeps MaxIdleTime
+// as a string, as JSON and HCL have no duration type.
+func (g *Generator) maxIdleTimeIsString() bool {
+	return g.config.ConfigFormat == "json" || g.config.ConfigFormat == "hcl"
+}
+
+// getEnvFallbackStatements returns the loadFromEnv statements filling the
+// optional config sections, mirroring getConfigLoadStatements.
+func (g *Generator) getEnvFallbackStatements() string {
+	maxIdleTime := "5 * time.Minute"
+	if g.maxIdleTimeIsString() {
+		maxIdleTime = `"5m"`
+	}
+
+	var sb strings.Builder
+
+	if g.config.DiscretePostgresConfig() {
+		// applyEnvOverrides fills these from the DB_* variables
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Database.Postgres = PostgresConfig{
+		Host:           "localhost",
+		Port:           5432,
+		User:           "user",
+		Password:       "password",
+		Name:           "dbname",
+		SSLMode:        %q,
+		MaxConnections: 25,
+		MaxIdleTime:    %s,
+	}
+`, g.dbSSLMode(), maxIdleTime))
+	} else if g.config.HasDatabase("postgres") {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Database.Postgres = PostgresConfig{
+		URL:            getEnv("%s", %q),
+		MaxConnections: 25,
+		MaxIdleTime:    %s,
+	}
+`, g.envVar("POSTGRES_URL"), g.defaultPostgresURL(), maxIdleTime))
+	}
+	if g.config.HasDatabase("mysql") {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Database.MySQL = MySQLConfig{
+		URL:            getEnv("%s", %q),
+		MaxConnections: 25,
+		MaxIdleTime:    %s,
+	}
+`, g.envVar("MYSQL_URL"), g.defaultMySQLURL(), maxIdleTime))
+	}
+	if g.config.HasDatabase("mongodb") {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Database.MongoDB = MongoDBConfig{
+		URL:         getEnv("%s", %q),
+		Database:    getEnv("%s", "dbname"),
+		MaxPoolSize: 100,
+		MinPoolSize: 10,
+	}
+`, g.envVar("MONGO_URL"), g.defaultMongoURL(), g.envVar("MONGO_DATABASE")))
+	}
+	if g.config.HasDatabase("redis") {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Cache.Redis = RedisConfig{
+		URL:          getEnv("%s", "redis://localhost:6379"),
+		PoolSize:     10,
+		MinIdleConns: 5,
+	}
+`, g.envVar("REDIS_URL")))
+	}
+	sb.WriteString(g.getTimeoutEnvFallbackStatements())
+	if g.config.EnableTracing {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Observability.Tracing = TracingConfig{
+		Enabled:      true,
+		OTLPEndpoint: getEnv("%s", "localhost:4317"),
+		ServiceName:  getEnv("%s", %q),
+		SampleRate:   1.0,
+	}
+`, g.envVar("OTLP_ENDPOINT"), g.envVar("SERVICE_NAME"), g.config.ProjectName))
+	}
+	if g.config.EnableMetrics {
+		sb.WriteString(fmt.Sprintf(`
+	cfg.Observability.Metrics = MetricsConfig{
+		Enabled: getEnvBool("%s", true),
+		Path:    "/metrics",
+	}
+`, g.envVar("METRICS_ENABLED")))
+	}
+
+	return sb.String()
+}
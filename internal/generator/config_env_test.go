@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ConfigEnvFallback(t *testing.T) {
+	for _, format := range []string{"yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.Databases = []string{"postgres", "redis"}
+			cfg.EnableMetrics = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, "os.IsNotExist(err)") || !strings.Contains(config, "cfg := loadFromEnv()") {
+				t.Error("Load should fall back to loadFromEnv when the config file is absent")
+			}
+
+			env := mfs.FileContent("/output/test-project/internal/config/env.go")
+			for _, check := range []string{
+				"func loadFromEnv() *Config {",
+				`getEnv("POSTGRES_URL", "postgres://`,
+				`getEnv("REDIS_URL", "redis://localhost:6379")`,
+				`getEnvBool("METRICS_ENABLED", true)`,
+				"cfg.applyEnvOverrides()",
+			} {
+				if !strings.Contains(env, check) {
+					t.Errorf("env.go should contain %q", check)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_ConfigEnvFallback_EnvFormat(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "env"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/config/env.go") {
+		t.Error("env format already reads the environment and should not generate env.go")
+	}
+}
@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_FeatureFlags(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, "func (c *Config) Feature(name string) bool {") {
+				t.Error("config.go should generate the Feature accessor")
+			}
+
+			helpers := config
+			if format != "env" {
+				if !strings.Contains(config, fieldTag(format, "features")) {
+					t.Errorf("Config should have a features section tagged for %s", format)
+				}
+				helpers = mfs.FileContent("/output/test-project/internal/config/env.go")
+			}
+			if !strings.Contains(helpers, `strings.CutPrefix(key, "FEATURE_")`) {
+				t.Error("feature flags should be read from FEATURE_* environment variables")
+			}
+		})
+	}
+}
+
+func TestGenerator_FeatureFlags_Examples(t *testing.T) {
+	tests := map[string]string{
+		"yaml": "features:\n  example_feature: false",
+		"json": "\"features\": {\n    \"example_feature\": false\n  }",
+		"toml": "[features]\nexample_feature = false",
+		"hcl":  "features = {\n  example_feature = false\n}",
+	}
+
+	for format, want := range tests {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			example := mfs.FileContent("/output/test-project/config." + format + ".example")
+			if !strings.Contains(example, want) {
+				t.Errorf("config.%s.example should document feature flags, want %q", format, want)
+			}
+		})
+	}
+}
+
+func TestGenerator_FeatureFlags_EnvPrefix(t *testing.T) {
+	tests := map[string]struct {
+		file string
+		want string
+	}{
+		"env":  {".env.example", "# MYAPP_FEATURE_EXAMPLE_FEATURE=true\n"},
+		"yaml": {"config.yaml.example", "# (MYAPP_FEATURE_<NAME>=true environment variables override these)\n"},
+		"toml": {"config.toml.example", "# (MYAPP_FEATURE_<NAME>=true environment variables override these)\n"},
+		"hcl":  {"config.hcl.example", "# (MYAPP_FEATURE_<NAME>=true environment variables override these)\n"},
+	}
+
+	for format, tt := range tests {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.EnvPrefix = "MYAPP"
+			cfg.EnvSample = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/"+tt.file), tt.want) {
+				t.Errorf("%s should name the prefixed feature flag variables, want %q", tt.file, tt.want)
+			}
+		})
+	}
+}
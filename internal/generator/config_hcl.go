@@ -0,0 +1,370 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fieldTag returns the struct tag mapping a structured config field to key in
+// the given format. HCL attributes are optional, so that omitted keys keep
+// their zero value as in the other formats.
+func fieldTag(tag, key string) string {
+	if tag == "hcl" {
+		key += ",optional"
+	}
+	return fmt.Sprintf("`%s:%q`", tag, key)
+}
+
+// sectionTag returns the struct tag of a field holding a nested config
+// section, which HCL decodes from a block.
+func sectionTag(tag, key string) string {
+	if tag == "hcl" {
+		key += ",block"
+	}
+	return fmt.Sprintf("`%s:%q`", tag, key)
+}
+
+// writeHCLAttributes writes attrs as {key, value} HCL attributes at indent,
+// aligning the equals signs as hclfmt does.
+func writeHCLAttributes(sb *strings.Builder, indent string, attrs [][2]string) {
+	width := 0
+	for _, attr := range attrs {
+		width = max(width, len(attr[0]))
+	}
+	for _, attr := range attrs {
+		sb.WriteString(fmt.Sprintf("%s%-*s = %s\n", indent, width, attr[0], attr[1]))
+	}
+}
+
+// getHCLConfigExample returns config.hcl.example.
+func (g *Generator) getHCLConfigExample() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s Configuration\n# ============================================\n\napp {\n", g.config.ProjectName))
+	appAttrs := [][2]string{
+		{"name", fmt.Sprintf("%q", g.config.ProjectName)},
+		{"environment", `"development" # development, staging, production`},
+		{"port", fmt.Sprintf("%d", g.config.AppPort())},
+		{"log_level", `"info" # debug, info, warn, error`},
+		{"drain_delay", fmt.Sprintf("%q # wait after failing readiness before shutdown", g.config.DrainDelay)},
+		{"slow_request_threshold", fmt.Sprintf("%q # warn about slower requests, 0s disables", g.config.SlowRequestThreshold)},
+	}
+	if g.config.MaxInflight > 0 {
+		appAttrs = append(appAttrs, [2]string{"max_inflight", fmt.Sprintf("%d # requests served at once, 503 beyond it", g.config.MaxInflight)})
+	}
+	if g.config.HTTP2 {
+		appAttrs = append(appAttrs, [2]string{"http2_max_concurrent_streams", fmt.Sprintf("%d # HTTP/2 streams per connection, 0 keeps the default of 250", g.config.MaxConcurrentStreams)})
+	}
+	writeHCLAttributes(&sb, "  ", appAttrs)
+	sb.WriteString("}\n")
+
+	// Database configuration
+	if g.needsDatabaseTimeout() {
+		sb.WriteString("\ndatabase {\n  timeout = \"" + defaultDependencyTimeout + "\" # bounds each database operation\n")
+		if g.config.HasDatabase("postgres") {
+			var attrs [][2]string
+			if g.config.DiscretePostgresConfig() {
+				attrs = g.getPostgresExampleSettings(true)
+			} else {
+				attrs = [][2]string{{"url", fmt.Sprintf("%q", g.defaultPostgresURL())}}
+			}
+			sb.WriteString("\n  postgres {\n")
+			writeHCLAttributes(&sb, "    ", append(attrs, [2]string{"max_connections", "25"}, [2]string{"max_idle_time", `"5m"`}))
+			sb.WriteString("  }\n")
+		}
+		if g.config.HasDatabase("mysql") {
+			sb.WriteString("\n  mysql {\n")
+			writeHCLAttributes(&sb, "    ", [][2]string{
+				{"url", fmt.Sprintf("%q", g.defaultMySQLURL())},
+				{"max_connections", "25"},
+				{"max_idle_time", `"5m"`},
+			})
+			sb.WriteString("  }\n")
+		}
+		if g.config.HasDatabase("mongodb") {
+			sb.WriteString("\n  mongodb {\n")
+			writeHCLAttributes(&sb, "    ", [][2]string{
+				{"url", fmt.Sprintf("%q", g.defaultMongoURL())},
+				{"database", `"dbname"`},
+				{"max_pool_size", "100"},
+				{"min_pool_size", "10"},
+			})
+			sb.WriteString("  }\n")
+		}
+		sb.WriteString("}\n")
+	}
+
+	// Cache configuration
+	if g.config.HasDatabase("redis") {
+		sb.WriteString(`
+cache {
+  timeout = "` + defaultDependencyTimeout + `" # bounds each cache operation
+
+  redis {
+    url            = "redis://localhost:6379"
+    pool_size      = 10
+    min_idle_conns = 5
+  }
+}
+`)
+	}
+
+	// Observability configuration
+	if g.config.EnableTracing || g.config.EnableMetrics {
+		sb.WriteString("\nobservability {\n")
+		if g.config.EnableTracing {
+			attrs := [][2]string{
+				{"enabled", "true"},
+				{"otlp_endpoint", `"localhost:4317"`},
+				{"service_name", fmt.Sprintf("%q", g.config.ProjectName)},
+				{"sample_rate", "1.0"},
+			}
+			if g.config.OTLPHeaders {
+				attrs = append(attrs, [2]string{"headers", `{} # e.g. { "x-api-key" = "your-api-key" } (OTLP_HEADERS overrides)`})
+			}
+			sb.WriteString("  tracing {\n")
+			writeHCLAttributes(&sb, "    ", attrs)
+			sb.WriteString("  }\n")
+		}
+		if g.config.EnableMetrics {
+			if g.config.EnableTracing {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(`  metrics {
+    enabled = true
+    path    = "/metrics"
+  }
+`)
+		}
+		sb.WriteString("}\n")
+	}
+
+	// Feature flags
+	sb.WriteString(`
+# Feature flags, read with cfg.Feature("name")
+# (` + g.envVar("FEATURE_") + `<NAME>=true environment variables override these)
+features = {
+  example_feature = false
+}
+`)
+
+	// Security configuration
+	sb.WriteString("\n" + g.getHCLCORSExample())
+
+	return sb.String()
+}
+
+func (g *Generator) generateHCLConfigLoader() error {
+	content := fmt.Sprintf(`package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+type Config struct {
+	App           AppConfig           `+"`hcl:\"app,block\"`"+`
+%s%s%s	Features      map[string]bool     `+"`hcl:\"features,optional\"`"+`
+
+	derived *derivedConfig // Cached by Load, see cached
+}
+
+type AppConfig struct {
+	Name                 string `+"`hcl:\"name,optional\"`"+`
+	Environment          string `+"`hcl:\"environment,optional\"`"+`
+	Port                 int    `+"`hcl:\"port,optional\"`"+`
+	LogLevel             string `+"`hcl:\"log_level,optional\"`"+`
+	DrainDelay           string `+"`hcl:\"drain_delay,optional\"`"+`
+	SlowRequestThreshold string `+"`hcl:\"slow_request_threshold,optional\"`"+"\n"+g.getMaxInflightStructField("hcl")+g.getMaxConcurrentStreamsStructField("hcl")+`}
+
+%s%s%s
+
+func Load() (*Config, error) {
+	configPath := os.Getenv("%s")
+	if configPath == "" {
+		configPath = "config.hcl"
+	}
+
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		// No config file: configure from environment variables only
+		cfg := loadFromEnv()
+		cfg.derived = cfg.derive()
+		return cfg, cfg.validate()
+	}
+
+	cfg := &Config{}
+%s
+	// Apply environment variable overrides
+	cfg.applyEnvOverrides()
+	cfg.derived = cfg.derive()
+
+	return cfg, cfg.validate()
+}
+
+func (c *Config) applyEnvOverrides() {
+	if env := os.Getenv("%s"); env != "" {
+		c.App.Environment = env
+	}
+	if port := os.Getenv("%s"); port != "" {
+		fmt.Sscanf(port, "%%d", &c.App.Port)
+	}
+	if drainDelay := os.Getenv("%s"); drainDelay != "" {
+		c.App.DrainDelay = drainDelay
+	}
+	if threshold := os.Getenv("%s"); threshold != "" {
+		c.App.SlowRequestThreshold = threshold
+	}%s
+	for name, enabled := range featuresFromEnv() {
+		if c.Features == nil {
+			c.Features = map[string]bool{}
+		}
+		c.Features[name] = enabled
+	}
+}
+
+func (c *Config) validate() error {
+	if c.App.Port == 0 {
+		return fmt.Errorf("app.port is required")
+	}%s
+	return nil
+}
+%s`, g.getHCLDatabaseConfigField(), g.getHCLCacheConfigField(), g.getHCLObservabilityConfigField()+g.getSecurityConfigField("hcl"),
+		g.getHCLDatabaseConfigTypes(), g.getHCLCacheConfigTypes(), g.getHCLObservabilityConfigTypes()+g.getSecurityConfigTypes("hcl"),
+		g.envVar("CONFIG_PATH"), g.getConfigDecodeStatement(), g.envVar("ENVIRONMENT"), g.envVar("PORT"), g.envVar("DRAIN_DELAY"),
+		g.envVar("SLOW_REQUEST_THRESHOLD"), g.getMaxInflightEnvOverride()+g.getMaxConcurrentStreamsEnvOverride()+g.getPostgresEnvOverrides()+g.getTimeoutEnvOverrides()+g.getCORSEnvOverride()+g.getOTLPHeadersEnvOverride(), g.getMaxConcurrentStreamsValidation(), g.generateConfigAccessors())
+
+	return g.writeFile("internal/config/config.go", content)
+}
+
+func (g *Generator) getHCLDatabaseConfigField() string {
+	if !g.config.NeedsSQL() && !g.config.NeedsNoSQL() {
+		return ""
+	}
+	return "\tDatabase      DatabaseConfig      `hcl:\"database,block\"`\n"
+}
+
+func (g *Generator) getHCLCacheConfigField() string {
+	if !g.config.NeedsCache() {
+		return ""
+	}
+	return "\tCache         CacheConfig         `hcl:\"cache,block\"`\n"
+}
+
+func (g *Generator) getHCLObservabilityConfigField() string {
+	if !g.config.EnableTracing && !g.config.EnableMetrics {
+		return ""
+	}
+	return "\tObservability ObservabilityConfig `hcl:\"observability,block\"`\n"
+}
+
+// getHCLDatabaseConfigTypes returns the database section types. HCL has no
+// duration type, so MaxIdleTime is kept as a string as in JSON.
+func (g *Generator) getHCLDatabaseConfigTypes() string {
+	if !g.config.NeedsSQL() && !g.config.NeedsNoSQL() {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("type DatabaseConfig struct {\n")
+	if g.config.HasDatabase("postgres") {
+		sb.WriteString("\tPostgres PostgresConfig `hcl:\"postgres,block\"`\n")
+	}
+	if g.config.HasDatabase("mysql") {
+		sb.WriteString("\tMySQL    MySQLConfig    `hcl:\"mysql,block\"`\n")
+	}
+	if g.config.HasDatabase("mongodb") {
+		sb.WriteString("\tMongoDB  MongoDBConfig  `hcl:\"mongodb,block\"`\n")
+	}
+	sb.WriteString(getTimeoutStructField("hcl", "database") + "}\n\n")
+
+	if g.config.HasDatabase("postgres") {
+		sb.WriteString(`type PostgresConfig struct {
+` + g.getPostgresConnectionFields("hcl", 6) + `	MaxConnections int    ` + "`hcl:\"max_connections,optional\"`" + `
+	MaxIdleTime    string ` + "`hcl:\"max_idle_time,optional\"`" + `
+}
+
+func (p *PostgresConfig) GetMaxIdleTime() time.Duration {
+	d, _ := time.ParseDuration(p.MaxIdleTime)
+	return d
+}
+
+`)
+	}
+	if g.config.HasDatabase("mysql") {
+		sb.WriteString(`type MySQLConfig struct {
+	URL            string ` + "`hcl:\"url,optional\"`" + `
+	MaxConnections int    ` + "`hcl:\"max_connections,optional\"`" + `
+	MaxIdleTime    string ` + "`hcl:\"max_idle_time,optional\"`" + `
+}
+
+func (m *MySQLConfig) GetMaxIdleTime() time.Duration {
+	d, _ := time.ParseDuration(m.MaxIdleTime)
+	return d
+}
+
+`)
+	}
+	if g.config.HasDatabase("mongodb") {
+		sb.WriteString(`type MongoDBConfig struct {
+	URL         string ` + "`hcl:\"url,optional\"`" + `
+	Database    string ` + "`hcl:\"database,optional\"`" + `
+	MaxPoolSize int    ` + "`hcl:\"max_pool_size,optional\"`" + `
+	MinPoolSize int    ` + "`hcl:\"min_pool_size,optional\"`" + `
+}
+
+`)
+	}
+
+	return sb.String()
+}
+
+func (g *Generator) getHCLCacheConfigTypes() string {
+	if !g.config.NeedsCache() {
+		return ""
+	}
+
+	return `type CacheConfig struct {
+	Redis RedisConfig ` + "`hcl:\"redis,block\"`" + `
+` + getTimeoutStructField("hcl", "cache") + `}
+
+type RedisConfig struct {
+	URL          string ` + "`hcl:\"url,optional\"`" + `
+	PoolSize     int    ` + "`hcl:\"pool_size,optional\"`" + `
+	MinIdleConns int    ` + "`hcl:\"min_idle_conns,optional\"`" + `
+}
+
+`
+}
+
+func (g *Generator) getHCLObservabilityConfigTypes() string {
+	if !g.config.EnableTracing && !g.config.EnableMetrics {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("type ObservabilityConfig struct {\n")
+	if g.config.EnableTracing {
+		sb.WriteString("\tTracing TracingConfig `hcl:\"tracing,block\"`\n")
+	}
+	if g.config.EnableMetrics {
+		sb.WriteString("\tMetrics MetricsConfig `hcl:\"metrics,block\"`\n")
+	}
+	sb.WriteString("}\n\n")
+
+	if g.config.EnableTracing {
+		sb.WriteString(g.getTracingConfigType("hcl"))
+	}
+	if g.config.EnableMetrics {
+		sb.WriteString(`type MetricsConfig struct {
+	Enabled bool   ` + "`hcl:\"enabled,optional\"`" + `
+	Path    string ` + "`hcl:\"path,optional\"`" + `
+}
+
+`)
+	}
+
+	return sb.String()
+}
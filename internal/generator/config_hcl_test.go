@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_HCLConfig(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "hcl"
+	cfg.Databases = []string{"postgres", "redis"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	example := mfs.FileContent("/output/test-project/config.hcl.example")
+	for _, want := range []string{
+		"app {\n",
+		"  port                   = 8080\n",
+		"database {\n  timeout = \"5s\"",
+		"  postgres {\n    url             = \"postgres://",
+		"cache {\n",
+		"  redis {\n    url            = \"redis://localhost:6379\"",
+	} {
+		if !strings.Contains(example, want) {
+			t.Errorf("config.hcl.example should contain %q", want)
+		}
+	}
+
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	for _, want := range []string{
+		`"github.com/hashicorp/hcl/v2/hclsimple"`,
+		"App           AppConfig           `hcl:\"app,block\"`",
+		"Database      DatabaseConfig      `hcl:\"database,block\"`",
+		"Port                 int    `hcl:\"port,optional\"`",
+		"MaxIdleTime    string `hcl:\"max_idle_time,optional\"`",
+		`configPath = "config.hcl"`,
+		"hclsimple.DecodeFile(configPath, nil, cfg)",
+		"func (c *Config) GetPort() string {",
+		"func (c *Config) GetPostgresURL() string {",
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("config.go should contain %q", want)
+		}
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "github.com/hashicorp/hcl/v2") {
+		t.Error("go.mod should require github.com/hashicorp/hcl/v2")
+	}
+	if !mfs.HasFile("/output/test-project/internal/config/env.go") {
+		t.Error("env.go should provide the environment fallback")
+	}
+}
@@ -0,0 +1,186 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// schemaObject is a JSON Schema object node with the given properties.
+// Unknown keys are rejected so typos show up in the editor.
+func schemaObject(properties map[string]any) map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+func schemaString(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+func schemaInteger(description string, minimum int) map[string]any {
+	return map[string]any{"type": "integer", "description": description, "minimum": minimum}
+}
+
+func schemaBool(description string) map[string]any {
+	return map[string]any{"type": "boolean", "description": description}
+}
+
+// getJSONSchemaField returns the Config field holding the "$schema" key of
+// config.json.example, which strict decoding would otherwise reject.
+func (g *Generator) getJSONSchemaField() string {
+	if !g.config.ConfigSchema {
+		return ""
+	}
+	return "\tSchema        string              `json:\"$schema,omitempty\"`\n"
+}
+
+// generateConfigSchema writes config.schema.json describing the structured
+// config file, with sections matching the ones generated in config_files.go.
+func (g *Generator) generateConfigSchema() error {
+	duration := map[string]any{
+		"type":        "string",
+		"description": "Go duration string (e.g., 5s, 1m)",
+		"pattern":     `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$|^0$`,
+	}
+
+	properties := map[string]any{
+		"$schema": schemaString("Path or URL of this schema"),
+		"app": schemaObject(map[string]any{
+			"name": schemaString("Application name"),
+			"environment": map[string]any{
+				"type": "string",
+				"enum": []string{"development", "staging", "production"},
+			},
+			"port": map[string]any{
+				"type":    "integer",
+				"minimum": 1,
+				"maximum": 65535,
+			},
+			"log_level": map[string]any{
+				"type": "string",
+				"enum": []string{"debug", "info", "warn", "error"},
+			},
+			"drain_delay":            duration,
+			"slow_request_threshold": duration,
+		}),
+	}
+	if g.config.MaxInflight > 0 {
+		app := properties["app"].(map[string]any)["properties"].(map[string]any)
+		app["max_inflight"] = schemaInteger("Requests served at once, 503 beyond it; 0 disables the limit", 0)
+	}
+	if g.config.HTTP2 {
+		app := properties["app"].(map[string]any)["properties"].(map[string]any)
+		app["http2_max_concurrent_streams"] = schemaInteger("HTTP/2 streams per connection; 0 keeps the x/net/http2 default of 250", 0)
+	}
+
+	if g.config.NeedsSQL() || g.config.NeedsNoSQL() {
+		databases := map[string]any{}
+		sqlSchema := func(name string) map[string]any {
+			return schemaObject(map[string]any{
+				"url":             schemaString(name + " connection string"),
+				"max_connections": schemaInteger("Maximum open connections", 1),
+				"max_idle_time":   duration,
+			})
+		}
+		if g.config.DiscretePostgresConfig() {
+			databases["postgres"] = schemaObject(map[string]any{
+				"host":            schemaString("PostgreSQL host"),
+				"port":            schemaInteger("PostgreSQL port", 1),
+				"user":            schemaString("PostgreSQL user"),
+				"password":        schemaString("PostgreSQL password"),
+				"name":            schemaString("PostgreSQL database name"),
+				"sslmode":         schemaString("PostgreSQL sslmode (e.g., disable, require, verify-full)"),
+				"max_connections": schemaInteger("Maximum open connections", 1),
+				"max_idle_time":   duration,
+			})
+		} else if g.config.HasDatabase("postgres") {
+			databases["postgres"] = sqlSchema("PostgreSQL")
+		}
+		if g.config.HasDatabase("mysql") {
+			databases["mysql"] = sqlSchema("MySQL")
+		}
+		if g.config.HasDatabase("mongodb") {
+			databases["mongodb"] = schemaObject(map[string]any{
+				"url":           schemaString("MongoDB connection string"),
+				"database":      schemaString("Database name"),
+				"max_pool_size": schemaInteger("Maximum connection pool size", 1),
+				"min_pool_size": schemaInteger("Minimum connection pool size", 0),
+			})
+		}
+		databases["timeout"] = duration
+		properties["database"] = schemaObject(databases)
+	}
+
+	if g.config.NeedsCache() {
+		properties["cache"] = schemaObject(map[string]any{
+			"timeout": duration,
+			"redis": schemaObject(map[string]any{
+				"url":            schemaString("Redis connection string"),
+				"pool_size":      schemaInteger("Connection pool size", 1),
+				"min_idle_conns": schemaInteger("Minimum idle connections", 0),
+			}),
+		})
+	}
+
+	if g.config.EnableTracing || g.config.EnableMetrics {
+		observability := map[string]any{}
+		if g.config.EnableTracing {
+			tracing := map[string]any{
+				"enabled":       schemaBool("Enable OpenTelemetry tracing"),
+				"otlp_endpoint": schemaString("OTLP gRPC endpoint (host:port)"),
+				"service_name":  schemaString("Service name reported in traces"),
+				"sample_rate": map[string]any{
+					"type":    "number",
+					"minimum": 0,
+					"maximum": 1,
+				},
+			}
+			if g.config.OTLPHeaders {
+				tracing["headers"] = map[string]any{
+					"type":                 "object",
+					"description":          "Headers sent with every OTLP export, e.g. collector API keys",
+					"additionalProperties": map[string]any{"type": "string"},
+				}
+			}
+			observability["tracing"] = schemaObject(tracing)
+		}
+		if g.config.EnableMetrics {
+			observability["metrics"] = schemaObject(map[string]any{
+				"enabled": schemaBool("Enable the Prometheus metrics endpoint"),
+				"path":    schemaString("Metrics endpoint path"),
+			})
+		}
+		properties["observability"] = schemaObject(observability)
+	}
+
+	if g.config.EnableCORS {
+		properties["security"] = schemaObject(map[string]any{
+			"cors": schemaObject(map[string]any{
+				"allowed_origins": map[string]any{
+					"type":        "array",
+					"description": "Origins allowed to make cross-origin requests; empty allows localhost in development only",
+					"items":       map[string]any{"type": "string"},
+				},
+			}),
+		})
+	}
+
+	properties["features"] = map[string]any{
+		"type":                 "object",
+		"description":          "Feature flags, read with cfg.Feature(name)",
+		"additionalProperties": map[string]any{"type": "boolean"},
+	}
+
+	schema := schemaObject(properties)
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = fmt.Sprintf("%s configuration", g.config.ProjectName)
+	schema["required"] = []string{"app"}
+
+	content, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config schema: %w", err)
+	}
+	return g.writeFile("config.schema.json", string(content)+"\n")
+}
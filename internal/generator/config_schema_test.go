@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ConfigSchema(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "yaml"
+	cfg.ConfigSchema = true
+	cfg.Databases = []string{"postgres"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"properties"`
+	}
+	content := mfs.FileContent("/output/test-project/config.schema.json")
+	if err := json.Unmarshal([]byte(content), &schema); err != nil {
+		t.Fatalf("config.schema.json is not valid JSON: %v", err)
+	}
+
+	database, ok := schema.Properties["database"]
+	if !ok {
+		t.Fatal("schema should include a database property")
+	}
+	if _, ok := database.Properties["postgres"]; !ok {
+		t.Error("database schema should include postgres")
+	}
+	if _, ok := database.Properties["mysql"]; ok {
+		t.Error("database schema should not include mysql when it is not configured")
+	}
+	if _, ok := schema.Properties["cache"]; ok {
+		t.Error("schema should not include cache without redis")
+	}
+
+	example := mfs.FileContent("/output/test-project/config.yaml.example")
+	if !strings.HasPrefix(example, "# yaml-language-server: $schema=config.schema.json") {
+		t.Error("config.yaml.example should reference the schema")
+	}
+}
+
+func TestGenerator_ConfigSchemaDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "yaml"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/config.schema.json") {
+		t.Error("config.schema.json should not be generated by default")
+	}
+}
+
+func TestGenerator_ConfigSchemaJSONField(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "json"
+	cfg.ConfigSchema = true
+	cfg.ConfigValidation = "strict"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/config.json.example"), `"$schema"`) {
+		t.Fatal("config.json.example should reference the schema")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/config/config.go"), "`json:\"$schema,omitempty\"`") {
+		t.Error("Config should accept the $schema key under strict decoding")
+	}
+}
@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getConfigExampleFile returns the config file example of a structured
+// config format, e.g. config.yaml.example, or "" for the env format.
+func (g *Generator) getConfigExampleFile() string {
+	switch g.config.ConfigFormat {
+	case "yaml", "json", "toml", "hcl":
+		return "config." + g.config.ConfigFormat + ".example"
+	default:
+		return ""
+	}
+}
+
+// generateConfigDiff writes cmd/configdiff, which prints the settings of the
+// loaded config that differ from the config file example: those a deployment
+// overrides with its config file or environment variables.
+func (g *Generator) generateConfigDiff() error {
+	example := g.getConfigExampleFile()
+	if example == "" {
+		return nil
+	}
+
+	var imports, decode string
+	switch g.config.ConfigFormat {
+	case "yaml":
+		imports = "\n\t\"gopkg.in/yaml.v3\"\n"
+		decode = `	data, err := os.ReadFile(examplePath)
+	if err == nil {
+		err = yaml.Unmarshal(data, &example)
+	}`
+	case "json":
+		imports = "\"encoding/json\"\n\t"
+		decode = `	data, err := os.ReadFile(examplePath)
+	if err == nil {
+		err = json.Unmarshal(data, &example)
+	}`
+	case "toml":
+		imports = "\n\t\"github.com/BurntSushi/toml\"\n"
+		decode = `	_, err = toml.DecodeFile(examplePath, &example)`
+	case "hcl":
+		imports = "\n\t\"github.com/hashicorp/hcl/v2/hclsimple\"\n"
+		// hclsimple picks the syntax by file extension, which .example lacks
+		decode = `	data, err := os.ReadFile(examplePath)
+	if err == nil {
+		err = hclsimple.Decode(strings.TrimSuffix(examplePath, ".example"), data, nil, &example)
+	}`
+	}
+
+	var stdImports, libImports string
+	if g.config.ConfigFormat == "json" {
+		stdImports = imports
+	} else {
+		libImports = imports
+	}
+
+	content := fmt.Sprintf(`// Command configdiff prints the settings of the loaded configuration that
+// differ from %[1]s, i.e. those set by the config file or by
+// environment variables. Values that may hold credentials are masked.
+//
+// Usage:
+//
+//	go run ./cmd/configdiff [example file]
+package main
+
+import (
+	%[2]s"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+%[3]s
+	"%[4]s/internal/config"
+)
+
+func main() {
+	examplePath := %[1]q
+	if len(os.Args) > 1 {
+		examplePath = os.Args[1]
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %%v\n", err)
+		os.Exit(1)
+	}
+
+	var example config.Config
+%[5]s
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read %%s: %%v\n", examplePath, err)
+		os.Exit(1)
+	}
+
+	want := map[string]string{}
+	flatten(reflect.ValueOf(&example), "", want)
+	got := map[string]string{}
+	flatten(reflect.ValueOf(cfg), "", got)
+
+	keys := make([]string, 0, len(want)+len(got))
+	for key := range want {
+		keys = append(keys, key)
+	}
+	for key := range got {
+		if _, ok := want[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	overridden := 0
+	for _, key := range keys {
+		if want[key] == got[key] {
+			continue
+		}
+		overridden++
+		fmt.Printf("%%s: %%s -> %%s\n", key, show(key, want), show(key, got))
+	}
+	if overridden == 0 {
+		fmt.Printf("The loaded config matches %%s\n", examplePath)
+	}
+}
+
+// flatten adds the settings of v to out by dotted key, e.g. app.port, named
+// after the keys of the config file.
+func flatten(v reflect.Value, prefix string, out map[string]string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			flatten(v.Elem(), prefix, out)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get(%[6]q), ",")
+			if name == "" || name == "-" {
+				name = field.Name
+			}
+			flatten(v.Field(i), join(prefix, name), out)
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			flatten(v.MapIndex(key), join(prefix, fmt.Sprint(key.Interface())), out)
+		}
+	default:
+		out[prefix] = fmt.Sprint(v.Interface())
+	}
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// show returns the value of key in settings for printing: quoted, masked if
+// it may hold credentials, or "(unset)".
+func show(key string, settings map[string]string) string {
+	value, ok := settings[key]
+	if !ok {
+		return "(unset)"
+	}
+	if secret(key) && value != "" {
+		return "****"
+	}
+	return fmt.Sprintf("%%q", value)
+}
+
+// secret reports whether the setting at key may hold credentials: a
+// connection URL, a password, or an OTLP header such as an API key.
+func secret(key string) bool {
+	key = strings.ToLower(key)
+	name := key[strings.LastIndex(key, ".")+1:]
+	return strings.HasSuffix(name, "url") || strings.Contains(name, "password") || strings.Contains(key, "headers")
+}
+`, example, stdImports, libImports, g.config.ModulePath, decode, g.config.ConfigFormat)
+
+	return g.writeFile("cmd/configdiff/main.go", content)
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ConfigDiff(t *testing.T) {
+	tests := []struct {
+		format string
+		decode string
+	}{
+		{"yaml", "err = yaml.Unmarshal(data, &example)"},
+		{"json", "err = json.Unmarshal(data, &example)"},
+		{"toml", "_, err = toml.DecodeFile(examplePath, &example)"},
+		{"hcl", `err = hclsimple.Decode(strings.TrimSuffix(examplePath, ".example"), data, nil, &example)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = tt.format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			example := "config." + tt.format + ".example"
+			tool := mfs.FileContent("/output/test-project/cmd/configdiff/main.go")
+			if !strings.Contains(tool, "examplePath := \""+example+"\"") {
+				t.Errorf("configdiff should compare against %s by default", example)
+			}
+			if !strings.Contains(tool, tt.decode) {
+				t.Errorf("configdiff should decode the example with %q", tt.decode)
+			}
+			if !strings.Contains(tool, `strings.Cut(field.Tag.Get("`+tt.format+`"), ",")`) {
+				t.Errorf("configdiff should name settings after the %s keys", tt.format)
+			}
+
+			makefile := mfs.FileContent("/output/test-project/Makefile")
+			if !strings.Contains(makefile, "config-diff: ## Show the loaded config values that differ from "+example+"\n\t@go run ./cmd/configdiff "+example+"\n") {
+				t.Error("Makefile should have a config-diff target running cmd/configdiff")
+			}
+		})
+	}
+}
+
+func TestGenerator_ConfigDiffEnvFormat(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/cmd/configdiff/main.go") {
+		t.Error("configdiff should not be generated for the env config format")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "config-diff") {
+		t.Error("Makefile should not have a config-diff target for the env config format")
+	}
+}
@@ -0,0 +1,168 @@
+package generator
+
+// getContentNegotiationImports returns the imports of the respond helper, or
+// nil without --content-negotiation.
+func (g *Generator) getContentNegotiationImports() []string {
+	if !g.config.ContentNegotiation {
+		return nil
+	}
+	return []string{`"encoding/xml"`, `"sort"`, `"strconv"`, `"strings"`}
+}
+
+// getRespondFunc returns respond, which the net/http handlers write their
+// responses with in the format the Accept header asks for, and the XML
+// encoding of Response, or "" without --content-negotiation.
+func (g *Generator) getRespondFunc() string {
+	if !g.config.ContentNegotiation {
+		return ""
+	}
+
+	return `
+// respond writes v with status in the format the Accept header of r asks
+// for: XML when it ranks application/xml or text/xml above application/json,
+// JSON otherwise.
+func respond(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	if !prefersXML(r.Header.Get("Accept")) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(v)
+}
+
+// prefersXML reports whether accept ranks XML above JSON by quality value.
+// JSON wins ties, wildcards, and headers naming neither.
+func prefersXML(accept string) bool {
+	var jsonQ, xmlQ float64
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "application/json":
+			jsonQ = max(jsonQ, q)
+		case "application/xml", "text/xml":
+			xmlQ = max(xmlQ, q)
+		}
+	}
+	return xmlQ > jsonQ
+}
+
+// MarshalXML encodes the response as a <response> element with one child per
+// field and per Data key, as encoding/xml can't encode maps.
+func (resp Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start = xml.StartElement{Name: xml.Name{Local: "response"}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLValue(e, "status", resp.Status); err != nil {
+		return err
+	}
+	if resp.Message != "" {
+		if err := encodeXMLValue(e, "message", resp.Message); err != nil {
+			return err
+		}
+	}
+	if len(resp.Data) > 0 {
+		if err := encodeXMLValue(e, "data", resp.Data); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXMLValue encodes v as the element name, maps as one child per key in
+// key order.
+func encodeXMLValue(e *xml.Encoder, name string, v interface{}) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return encodeXMLMap(e, start, m)
+	case map[string]string:
+		return encodeXMLMap(e, start, m)
+	default:
+		return e.EncodeElement(v, start)
+	}
+}
+
+func encodeXMLMap[V any](e *xml.Encoder, start xml.StartElement, m map[string]V) error {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := encodeXMLValue(e, key, m[key]); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+`
+}
+
+// getRespondHandlerTests returns the generated tests of the Accept header
+// negotiation, or "" without --content-negotiation.
+func (g *Generator) getRespondHandlerTests() string {
+	if !g.config.ContentNegotiation {
+		return ""
+	}
+	return `
+func (suite *HandlerTestSuite) TestIndexRespondsXMLWhenAccepted() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+
+	suite.handler.Index(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+	suite.Contains(w.Body.String(), "<response><status>ok</status>")
+	suite.Contains(w.Body.String(), "<version>1.0.0</version>")
+}
+
+func TestPrefersXML(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"*/*", false},
+		{"application/json", false},
+		{"application/xml", true},
+		{"text/xml", true},
+		{"application/json, application/xml", false},
+		{"application/json;q=0.5, application/xml", true},
+		{"application/xml;q=0.4, application/json;q=0.9", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, prefersXML(tt.accept), tt.accept)
+	}
+}
+`
+}
+
+// getXMLTag returns the xml struct tag of a response field named name, with
+// a leading space, or "" without --content-negotiation.
+func (g *Generator) getXMLTag(name string) string {
+	if !g.config.ContentNegotiation {
+		return ""
+	}
+	return ` xml:"` + name + `"`
+}
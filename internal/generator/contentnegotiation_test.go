@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ContentNegotiation(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.ContentNegotiation = true
+			cfg.Validator = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			for _, want := range []string{
+				"func respond(w http.ResponseWriter, r *http.Request, status int, v interface{}) {",
+				"func (resp Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {",
+				"respond(w, r, http.StatusServiceUnavailable, notReadyResponse)",
+				"respond(w, r, http.StatusUnprocessableEntity, ValidationErrorResponse{",
+				"XMLName xml.Name              `json:\"-\" xml:\"response\"`",
+			} {
+				if !strings.Contains(handlers, want) {
+					t.Errorf("handlers.go should contain %q", want)
+				}
+			}
+			if strings.Contains(handlers, "json.NewEncoder(w).Encode(Response{") {
+				t.Error("handlers should write their responses with respond")
+			}
+
+			tests := mfs.FileContent("/output/test-project/internal/handlers/handlers_test.go")
+			if !strings.Contains(tests, "req.Header.Set(\"Accept\", \"application/xml\")") ||
+				!strings.Contains(tests, "suite.Contains(w.Body.String(), \"<response><status>ok</status>\")") {
+				t.Error("handlers_test.go should assert an XML Accept header yields XML")
+			}
+		})
+	}
+}
+
+func TestGenerator_ContentNegotiationDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	for _, unwanted := range []string{"respond(", "encoding/xml"} {
+		if strings.Contains(handlers, unwanted) {
+			t.Errorf("handlers.go should not contain %q without --content-negotiation", unwanted)
+		}
+	}
+}
@@ -0,0 +1,346 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getCORSMiddleware returns the CORSPolicy shared by every framework and the
+// CORS middleware for the configured framework, or "" when CORS is disabled.
+func (g *Generator) getCORSMiddleware(loggerType string) string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+
+	warn := `logger.Warn("No CORS allowed origins configured; denying cross-origin requests",
+				"env", %[1]q, "environment", environment)`
+	switch g.config.Logger {
+	case "slog":
+		warn = `logger.Warn("No CORS allowed origins configured; denying cross-origin requests",
+				slog.String("env", %[1]q), slog.String("environment", environment))`
+	case "zap":
+		warn = `logger.Warn("No CORS allowed origins configured; denying cross-origin requests",
+				zap.String("env", %[1]q), zap.String("environment", environment))`
+	case "zerolog":
+		warn = `logger.Warn().Str("env", %[1]q).Str("environment", environment).
+				Msg("No CORS allowed origins configured; denying cross-origin requests")`
+	}
+
+	return fmt.Sprintf(`
+const (
+	corsAllowMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+	corsAllowHeaders = "Content-Type, Authorization, X-Request-ID"
+)
+
+// CORSPolicy decides which origins may make cross-origin requests.
+type CORSPolicy struct {
+	origins        map[string]bool
+	allowLocalhost bool
+}
+
+// NewCORSPolicy returns the policy for the configured origins ("*" allows
+// any origin). Without configured origins, development allows localhost
+// origins while other environments deny cross-origin requests and warn.
+func NewCORSPolicy(origins []string, environment string, logger %s) *CORSPolicy {
+	p := &CORSPolicy{origins: make(map[string]bool, len(origins))}
+	for _, origin := range origins {
+		p.origins[origin] = true
+	}
+	if len(origins) == 0 {
+		if environment == "development" {
+			p.allowLocalhost = true
+		} else {
+			%s
+		}
+	}
+	return p
+}
+
+// Allowed reports whether origin may make cross-origin requests.
+func (p *CORSPolicy) Allowed(origin string) bool {
+	if p.origins[origin] || p.origins["*"] {
+		return true
+	}
+	if !p.allowLocalhost {
+		return false
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	switch u.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
+%s`, loggerType, fmt.Sprintf(warn, g.envVar("CORS_ALLOWED_ORIGINS")), g.getFrameworkCORSMiddleware())
+}
+
+// getFrameworkCORSMiddleware returns the CORS middleware applying a
+// CORSPolicy with the configured framework's API. Requests from origins
+// the policy rejects get no CORS headers, so browsers block them.
+func (g *Generator) getFrameworkCORSMiddleware() string {
+	switch g.config.Framework {
+	case "gin":
+		return `
+// GinCORS sets the CORS headers for origins allowed by policy and answers
+// preflight requests.
+func GinCORS(policy *CORSPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		c.Writer.Header().Add("Vary", "Origin")
+		if origin == "" || !policy.Allowed(origin) {
+			c.Next()
+			return
+		}
+		c.Header("Access-Control-Allow-Origin", origin)
+		if c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != "" {
+			c.Header("Access-Control-Allow-Methods", corsAllowMethods)
+			c.Header("Access-Control-Allow-Headers", corsAllowHeaders)
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
+`
+	case "fiber":
+		return `
+// FiberCORS sets the CORS headers for origins allowed by policy and answers
+// preflight requests.
+func FiberCORS(policy *CORSPolicy) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		origin := c.Get("Origin")
+		c.Vary("Origin")
+		if origin == "" || !policy.Allowed(origin) {
+			return c.Next()
+		}
+		c.Set("Access-Control-Allow-Origin", origin)
+		if c.Method() == fiber.MethodOptions && c.Get("Access-Control-Request-Method") != "" {
+			c.Set("Access-Control-Allow-Methods", corsAllowMethods)
+			c.Set("Access-Control-Allow-Headers", corsAllowHeaders)
+			return c.SendStatus(fiber.StatusNoContent)
+		}
+		return c.Next()
+	}
+}
+`
+	case "fasthttp":
+		return `
+// FastHTTPCORS sets the CORS headers for origins allowed by policy and
+// answers preflight requests.
+func FastHTTPCORS(next fasthttp.RequestHandler, policy *CORSPolicy) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		origin := string(ctx.Request.Header.Peek("Origin"))
+		ctx.Response.Header.Add("Vary", "Origin")
+		if origin == "" || !policy.Allowed(origin) {
+			next(ctx)
+			return
+		}
+		ctx.Response.Header.Set("Access-Control-Allow-Origin", origin)
+		if ctx.IsOptions() && len(ctx.Request.Header.Peek("Access-Control-Request-Method")) > 0 {
+			ctx.Response.Header.Set("Access-Control-Allow-Methods", corsAllowMethods)
+			ctx.Response.Header.Set("Access-Control-Allow-Headers", corsAllowHeaders)
+			ctx.SetStatusCode(fasthttp.StatusNoContent)
+			return
+		}
+		next(ctx)
+	}
+}
+`
+	default:
+		// net/http middleware, also used by chi and, wrapped, by echo
+		return `
+// CORS sets the CORS headers for origins allowed by policy and answers
+// preflight requests.
+func CORS(policy *CORSPolicy) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			w.Header().Add("Vary", "Origin")
+			if origin == "" || !policy.Allowed(origin) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", corsAllowMethods)
+				w.Header().Set("Access-Control-Allow-Headers", corsAllowHeaders)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+`
+	}
+}
+
+// getCORSPolicyExpr returns the expression building the server's CORSPolicy
+// from the configured origins and environment, using the middleware package
+// imported as pkg.
+func (g *Generator) getCORSPolicyExpr(pkg string) string {
+	return fmt.Sprintf("%s.NewCORSPolicy(%s, %s, obs.Logger)", pkg,
+		g.getConfigFieldReference("CORSAllowedOrigins"), g.getConfigFieldReference("Environment"))
+}
+
+// getCORSConfigFields returns the env-format Config field holding the CORS
+// allowed origins.
+func (g *Generator) getCORSConfigFields() string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return `
+	// CORSAllowedOrigins lists the origins allowed to make cross-origin
+	// requests. Empty allows localhost in development and denies elsewhere.
+	CORSAllowedOrigins []string
+`
+}
+
+// getCORSLoadStatement returns the env-format Load statement reading the CORS
+// allowed origins.
+func (g *Generator) getCORSLoadStatement() string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return fmt.Sprintf("\n\tcfg.CORSAllowedOrigins = getEnvList(%q)\n", g.envVar("CORS_ALLOWED_ORIGINS"))
+}
+
+// getCORSEnvOverride returns the applyEnvOverrides statement letting
+// CORS_ALLOWED_ORIGINS override the structured config's allowed origins.
+func (g *Generator) getCORSEnvOverride() string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return fmt.Sprintf(`
+	if origins := getEnvList(%q); len(origins) > 0 {
+		c.Security.CORS.AllowedOrigins = origins
+	}`, g.envVar("CORS_ALLOWED_ORIGINS"))
+}
+
+// getEnvListFunc returns the getEnvList helper reading comma-separated
+// environment variables such as CORS_ALLOWED_ORIGINS.
+func (g *Generator) getEnvListFunc() string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return `
+// getEnvList returns the comma-separated items of the environment variable
+// key, trimmed and without empty items.
+func getEnvList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+`
+}
+
+// getSecurityConfigField returns the structured Config field holding the
+// security section, tagged for the given format.
+func (g *Generator) getSecurityConfigField(tag string) string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return fmt.Sprintf("\tSecurity      SecurityConfig      %s\n", sectionTag(tag, "security"))
+}
+
+// getSecurityConfigTypes returns the structured security section types,
+// tagged for the given format.
+func (g *Generator) getSecurityConfigTypes(tag string) string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return fmt.Sprintf(`type SecurityConfig struct {
+	CORS CORSConfig %s
+}
+
+// CORSConfig lists the origins allowed to make cross-origin requests. Empty
+// allows localhost in development and denies elsewhere.
+type CORSConfig struct {
+	AllowedOrigins []string %s
+}
+
+`, sectionTag(tag, "cors"), fieldTag(tag, "allowed_origins"))
+}
+
+// getTOMLCORSExample returns the [security.cors] section of the TOML config
+// example, commented out unless CORS is enabled.
+func (g *Generator) getTOMLCORSExample() string {
+	if !g.config.EnableCORS {
+		return `# [security.cors]
+# allowed_origins = ["http://localhost:3000", "https://yourdomain.com"]
+`
+	}
+	return `[security.cors]
+# Empty allows localhost origins in development and denies cross-origin
+# requests elsewhere (CORS_ALLOWED_ORIGINS overrides)
+allowed_origins = []
+`
+}
+
+// getHCLCORSExample returns the security block of the HCL config example,
+// commented out unless CORS is enabled.
+func (g *Generator) getHCLCORSExample() string {
+	if !g.config.EnableCORS {
+		return `# Security configuration (optional)
+# security {
+#   cors {
+#     allowed_origins = ["http://localhost:3000", "https://yourdomain.com"]
+#   }
+# }
+`
+	}
+	return `security {
+  cors {
+    # Empty allows localhost origins in development and denies cross-origin
+    # requests elsewhere (CORS_ALLOWED_ORIGINS overrides)
+    allowed_origins = []
+  }
+}
+`
+}
+
+// getCORSMiddlewareTests returns the generated tests of the net/http CORS
+// middleware, building loggers with loggerInit.
+func (g *Generator) getCORSMiddlewareTests(loggerInit string) string {
+	if !g.config.EnableCORS {
+		return ""
+	}
+	return fmt.Sprintf(`
+func TestCORS_AllowsLocalhostInDevelopmentByDefault(t *testing.T) {
+	h := CORS(NewCORSPolicy(nil, "development", %[1]s))(http.NotFoundHandler())
+
+	r := httptest.NewRequest(http.MethodOptions, "/", nil)
+	r.Header.Set("Origin", "http://localhost:3000")
+	r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "http://localhost:3000", w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORS_DeniesWhenProductionOriginsEmpty(t *testing.T) {
+	h := CORS(NewCORSPolicy(nil, "production", %[1]s))(http.NotFoundHandler())
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Origin", "http://localhost:3000")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORS_AllowsConfiguredOrigins(t *testing.T) {
+	policy := NewCORSPolicy([]string{"https://example.com"}, "production", %[1]s)
+
+	assert.True(t, policy.Allowed("https://example.com"))
+	assert.False(t, policy.Allowed("https://evil.example"))
+}
+`, loggerInit)
+}
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_CORSDeniesWhenProductionOriginsEmpty(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		for _, logger := range []string{"slog", "zap", "zerolog"} {
+			t.Run(framework+"/"+logger, func(t *testing.T) {
+				cfg := createTestConfig()
+				cfg.Framework = framework
+				cfg.Logger = logger
+				cfg.EnableCORS = true
+				gen, mfs := createTestGenerator(cfg)
+
+				if err := gen.Generate(); err != nil {
+					t.Fatalf("Generate failed: %v", err)
+				}
+
+				middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+				policy := `	if len(origins) == 0 {
+		if environment == "development" {
+			p.allowLocalhost = true
+		} else {`
+				if !strings.Contains(middleware, policy) {
+					t.Error("NewCORSPolicy should only allow localhost by default in development")
+				}
+				if !strings.Contains(middleware, "No CORS allowed origins configured; denying cross-origin requests") {
+					t.Error("NewCORSPolicy should warn when no origins are configured outside development")
+				}
+				if !strings.Contains(middleware, "if !p.allowLocalhost {\n\t\treturn false\n\t}") {
+					t.Error("Allowed should deny unlisted origins unless localhost is allowed")
+				}
+				if !strings.Contains(middleware, "origin == \"\" || !policy.Allowed(origin)") {
+					t.Error("CORS middleware should not set CORS headers for denied origins")
+				}
+
+				server := mfs.FileContent("/output/test-project/internal/server/server.go")
+				if !strings.Contains(server, "NewCORSPolicy(cfg.CORSAllowedOrigins, cfg.Environment, obs.Logger)") {
+					t.Error("server.go should build the CORS policy from the configured origins and environment")
+				}
+			})
+		}
+	}
+}
+
+func TestGenerator_CORSConfig(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		cfg := createTestConfig()
+		cfg.EnableCORS = true
+		cfg.EnvPrefix = "MYAPP"
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		config := mfs.FileContent("/output/test-project/internal/config/config.go")
+		if !strings.Contains(config, `cfg.CORSAllowedOrigins = getEnvList("MYAPP_CORS_ALLOWED_ORIGINS")`) {
+			t.Error("config.go should read the allowed origins from MYAPP_CORS_ALLOWED_ORIGINS")
+		}
+		if !strings.Contains(config, "func getEnvList(key string) []string {") {
+			t.Error("config.go should define getEnvList")
+		}
+
+		env := mfs.FileContent("/output/test-project/.env.example")
+		if !strings.Contains(env, "\nMYAPP_CORS_ALLOWED_ORIGINS=\n") {
+			t.Error(".env.example should set an empty MYAPP_CORS_ALLOWED_ORIGINS")
+		}
+	})
+
+	for _, format := range []string{"yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.EnableCORS = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, "AllowedOrigins []string "+fieldTag(format, "allowed_origins")) {
+				t.Errorf("config.go should have a %s-tagged allowed_origins field", format)
+			}
+			if !strings.Contains(config, `if origins := getEnvList("CORS_ALLOWED_ORIGINS"); len(origins) > 0 {`) {
+				t.Error("applyEnvOverrides should let CORS_ALLOWED_ORIGINS override the config file")
+			}
+			if !strings.Contains(config, "func (c *Config) GetCORSAllowedOrigins() []string {") {
+				t.Error("config.go should define the GetCORSAllowedOrigins accessor")
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, "NewCORSPolicy(cfg.GetCORSAllowedOrigins(), cfg.GetEnvironment(), obs.Logger)") {
+				t.Error("server.go should use the config accessors for the CORS policy")
+			}
+
+			example := mfs.FileContent("/output/test-project/config." + format + ".example")
+			if !strings.Contains(example, "allowed_origins") {
+				t.Errorf("config.%s.example should include allowed_origins", format)
+			}
+		})
+	}
+}
+
+func TestGenerator_CORSDisabledByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnvSample = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, file := range []string{"internal/middleware/middleware.go", "internal/server/server.go", "internal/config/config.go"} {
+		if strings.Contains(mfs.FileContent("/output/test-project/"+file), "CORS") {
+			t.Errorf("%s should not mention CORS unless enabled", file)
+		}
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "CORS_ALLOWED_ORIGINS") {
+		t.Error(".env.example should not mention CORS_ALLOWED_ORIGINS unless enabled")
+	}
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"strings"
 )
 
 func (g *Generator) generateDatabasePackages() error {
@@ -23,11 +24,94 @@ func (g *Generator) generateDatabasePackages() error {
 		}
 	}
 
+	if g.config.TxHelper {
+		if err := g.generateTxHelper(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// generateTxHelper writes internal/database/tx.go with a WithTx method on
+// each SQL database, running a callback in a transaction.
+func (g *Generator) generateTxHelper() error {
+	imports := []string{`"context"`}
+	if g.config.HasDatabase("mysql") {
+		imports = append(imports, `"database/sql"`)
+	}
+	imports = append(imports, `"fmt"`)
+	if g.config.HasDatabase("postgres") {
+		imports = append(imports, "", `"github.com/jackc/pgx/v5"`)
+	}
+
+	var methods string
+	if g.config.HasDatabase("postgres") {
+		methods += `
+// WithTx runs fn in a transaction, committing it if fn returns nil and
+// rolling it back if fn returns an error or panics. The error of fn is
+// returned as is.
+func (db *PostgresDB) WithTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := db.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Rolling back a committed transaction is a no-op
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+`
+	}
+	if g.config.HasDatabase("mysql") {
+		methods += `
+// WithTx runs fn in a transaction, committing it if fn returns nil and
+// rolling it back if fn returns an error or panics. The error of fn is
+// returned as is.
+func (db *MySQLDB) WithTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Rolling back a committed transaction only returns sql.ErrTxDone
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+`
+	}
+
+	lines := make([]string, len(imports))
+	for i, imp := range imports {
+		if imp != "" {
+			lines[i] = "\t" + imp
+		}
+	}
+	content := fmt.Sprintf(`package database
+
+import (
+%s
+)
+%s`, strings.Join(lines, "\n"), methods)
+
+	return g.writeFile("internal/database/tx.go", content)
+}
+
 func (g *Generator) generatePostgresDB() error {
 	urlRef := g.getConfigFieldReference("PostgresURL")
+	timeoutRef := g.getConfigFieldReference("DBTimeout")
 	content := fmt.Sprintf(`package database
 
 import (
@@ -48,7 +132,12 @@ func NewPostgresDB(ctx context.Context, cfg *config.Config) (*PostgresDB, error)
 		return nil, fmt.Errorf("failed to create connection pool: %%w", err)
 	}
 
-	if err := pool.Ping(ctx); err != nil {
+	// Bound the ping without shortening the caller's context
+	pingCtx, cancel := context.WithTimeout(ctx, %s)
+	defer cancel()
+
+	if err := pool.Ping(pingCtx); err != nil {
+		pool.Close()
 		return nil, fmt.Errorf("failed to ping database: %%w", err)
 	}
 
@@ -64,19 +153,22 @@ func (db *PostgresDB) Close() {
 func (db *PostgresDB) Pool() *pgxpool.Pool {
 	return db.pool
 }
-`, g.config.ModulePath, urlRef)
+`, g.config.ModulePath, urlRef, timeoutRef)
 
 	return g.writeFile("internal/database/postgres.go", content)
 }
 
 func (g *Generator) generateMySQLDB() error {
 	urlRef := g.getConfigFieldReference("MySQLURL")
+	timeoutRef := g.getConfigFieldReference("DBTimeout")
 	content := fmt.Sprintf(`package database
 
 import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -88,7 +180,7 @@ type MySQLDB struct {
 }
 
 func NewMySQLDB(ctx context.Context, cfg *config.Config) (*MySQLDB, error) {
-	db, err := sql.Open("mysql", %s)
+	db, err := sql.Open("mysql", ensureTimeParams(%s))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %%w", err)
 	}
@@ -97,7 +189,12 @@ func NewMySQLDB(ctx context.Context, cfg *config.Config) (*MySQLDB, error) {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	if err := db.PingContext(ctx); err != nil {
+	// Bound the ping without shortening the caller's context
+	pingCtx, cancel := context.WithTimeout(ctx, %s)
+	defer cancel()
+
+	if err := db.PingContext(pingCtx); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %%w", err)
 	}
 
@@ -114,13 +211,37 @@ func (db *MySQLDB) Close() error {
 func (db *MySQLDB) DB() *sql.DB {
 	return db.db
 }
-`, g.config.ModulePath, urlRef)
+
+// ensureTimeParams appends parseTime=true and loc=UTC to the DSN unless they
+// are already set, so DATETIME and TIMESTAMP columns scan into time.Time.
+func ensureTimeParams(dsn string) string {
+	base, query, _ := strings.Cut(dsn, "?")
+	params, _ := url.ParseQuery(query)
+
+	var missing []string
+	if !params.Has("parseTime") {
+		missing = append(missing, "parseTime=true")
+	}
+	if !params.Has("loc") {
+		missing = append(missing, "loc=UTC")
+	}
+	if len(missing) == 0 {
+		return dsn
+	}
+
+	if query == "" {
+		return base + "?" + strings.Join(missing, "&")
+	}
+	return dsn + "&" + strings.Join(missing, "&")
+}
+`, g.config.ModulePath, urlRef, timeoutRef)
 
 	return g.writeFile("internal/database/mysql.go", content)
 }
 
 func (g *Generator) generateMongoDB() error {
 	urlRef := g.getConfigFieldReference("MongoURL")
+	timeoutRef := g.getConfigFieldReference("DBTimeout")
 	content := fmt.Sprintf(`package database
 
 import (
@@ -138,17 +259,21 @@ type MongoDB struct {
 }
 
 func NewMongoDB(ctx context.Context, cfg *config.Config) (*MongoDB, error) {
-	clientOptions := options.Client().ApplyURI(%s)
-	
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
+	clientOptions := options.Client().
+		ApplyURI(%s).
+		SetConnectTimeout(10 * time.Second)
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %%w", err)
 	}
 
-	if err := client.Ping(ctx, nil); err != nil {
+	// Bound the ping without shortening the caller's context
+	pingCtx, cancel := context.WithTimeout(ctx, %s)
+	defer cancel()
+
+	if err := client.Ping(pingCtx, nil); err != nil {
+		client.Disconnect(ctx)
 		return nil, fmt.Errorf("failed to ping MongoDB: %%w", err)
 	}
 
@@ -169,25 +294,32 @@ func (db *MongoDB) Client() *mongo.Client {
 func (db *MongoDB) Database(name string) *mongo.Database {
 	return db.client.Database(name)
 }
-`, g.config.ModulePath, urlRef)
+`, g.config.ModulePath, urlRef, timeoutRef)
 
 	return g.writeFile("internal/database/mongodb.go", content)
 }
 
 func (g *Generator) generateCachePackage() error {
 	urlRef := g.getConfigFieldReference("RedisURL")
+	timeoutRef := g.getConfigFieldReference("CacheTimeout")
 	content := fmt.Sprintf(`package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"%s/internal/config"
 )
 
+// ErrMiss is returned by Get when the key is not cached.
+var ErrMiss = errors.New("cache miss")
+
 type RedisCache struct {
-	client *redis.Client
+	client  *redis.Client
+	timeout time.Duration // Bounds each cache operation
 }
 
 func NewRedisCache(ctx context.Context, cfg *config.Config) (*RedisCache, error) {
@@ -198,11 +330,38 @@ func NewRedisCache(ctx context.Context, cfg *config.Config) (*RedisCache, error)
 
 	client := redis.NewClient(opts)
 
-	if err := client.Ping(ctx).Err(); err != nil {
+	c := &RedisCache{client: client, timeout: %s}
+
+	// Bound the ping without shortening the caller's context
+	pingCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to ping Redis: %%w", err)
 	}
 
-	return &RedisCache{client: client}, nil
+	return c, nil
+}
+
+// Get returns the value cached under key, or ErrMiss when there is none.
+func (c *RedisCache) Get(ctx context.Context, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	value, err := c.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrMiss
+	}
+	return value, err
+}
+
+// Set caches value under key for ttl.
+func (c *RedisCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return c.client.Set(ctx, key, value, ttl).Err()
 }
 
 func (c *RedisCache) Close() error {
@@ -215,7 +374,7 @@ func (c *RedisCache) Close() error {
 func (c *RedisCache) Client() *redis.Client {
 	return c.client
 }
-`, g.config.ModulePath, urlRef)
+`, g.config.ModulePath, urlRef, timeoutRef)
 
 	return g.writeFile("internal/cache/redis.go", content)
 }
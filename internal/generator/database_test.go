@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MySQLTimeParams(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Databases = []string{"mysql"}
+			cfg.ConfigFormat = format
+			cfg.IncludeDocker = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			files := []string{".env.example", "docker-compose.yml"}
+			if format == "env" {
+				files = append(files, "internal/config/config.go")
+			} else {
+				files = append(files, "config."+format+".example")
+			}
+			for _, f := range files {
+				content := mfs.FileContent("/output/test-project/" + f)
+				if !strings.Contains(content, "/dbname?parseTime=true&loc=UTC") {
+					t.Errorf("%s: default MySQL URL should contain parseTime=true&loc=UTC", f)
+				}
+			}
+
+			mysql := mfs.FileContent("/output/test-project/internal/database/mysql.go")
+			if !strings.Contains(mysql, "sql.Open(\"mysql\", ensureTimeParams(") {
+				t.Error("mysql.go should ensure time params on the configured DSN")
+			}
+		})
+	}
+}
+
+func TestGenerator_DatabasePingTimeouts(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres", "mysql", "mongodb"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, file := range []string{"postgres.go", "mysql.go", "mongodb.go"} {
+		content := mfs.FileContent("/output/test-project/internal/database/" + file)
+		if !strings.Contains(content, "pingCtx, cancel := context.WithTimeout(ctx, cfg.DBTimeout)") {
+			t.Errorf("%s should bound Ping with its own context", file)
+		}
+		if strings.Contains(content, "ctx, cancel := context.WithTimeout(ctx,") {
+			t.Errorf("%s should not shadow the caller's ctx with a timeout context", file)
+		}
+	}
+
+	mongo := mfs.FileContent("/output/test-project/internal/database/mongodb.go")
+	if !strings.Contains(mongo, "client.Ping(pingCtx, nil)") {
+		t.Error("mongodb.go should ping with pingCtx")
+	}
+}
+
+func TestGenerator_DiscretePostgresConfig(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Databases = []string{"postgres"}
+			cfg.ConfigFormat = format
+			cfg.DBConfigStyle = "discrete"
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			fields := []string{"DBHost", "DBPort", "DBUser", "DBPassword", "DBName"}
+			if format != "env" {
+				fields = []string{`Host `, `Port `, `User `, `Password `, `Name `}
+			}
+			for _, field := range fields {
+				if !strings.Contains(config, field) {
+					t.Errorf("config.go should contain the discrete %q field", field)
+				}
+			}
+			if strings.Contains(config, "PostgresURL") || strings.Contains(config, `"url"`) {
+				t.Error("discrete style should not generate a Postgres URL field")
+			}
+
+			dsn := mfs.FileContent("/output/test-project/internal/config/dsn.go")
+			if !strings.Contains(dsn, "func (c *Config) PostgresDSN() string {") {
+				t.Error("dsn.go should define the PostgresDSN builder")
+			}
+
+			postgres := mfs.FileContent("/output/test-project/internal/database/postgres.go")
+			if !strings.Contains(postgres, "pgxpool.New(ctx, cfg.PostgresDSN())") {
+				t.Error("postgres.go should connect with the assembled DSN")
+			}
+
+			env := mfs.FileContent("/output/test-project/.env.example")
+			if !strings.Contains(env, "DB_HOST=localhost") || strings.Contains(env, "POSTGRES_URL") {
+				t.Error(".env.example should document DB_* settings instead of POSTGRES_URL")
+			}
+		})
+	}
+}
+
+func TestGenerator_URLPostgresConfigByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/config/dsn.go") {
+		t.Error("url style should not generate the DSN builder")
+	}
+	postgres := mfs.FileContent("/output/test-project/internal/database/postgres.go")
+	if !strings.Contains(postgres, "pgxpool.New(ctx, cfg.PostgresURL)") {
+		t.Error("postgres.go should connect with the configured URL")
+	}
+}
+
+func TestGenerator_DBSSLMode(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Databases = []string{"postgres", "mysql", "mongodb"}
+			cfg.ConfigFormat = format
+			cfg.DBSSLMode = "require"
+			cfg.IncludeDocker = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			files := []string{".env.example", "internal/config/config.go"}
+			if format != "env" {
+				files = []string{".env.example", "internal/config/env.go", "config." + format + ".example"}
+			}
+			for _, f := range files {
+				content := mfs.FileContent("/output/test-project/" + f)
+				for _, check := range []string{
+					"@localhost:5432/dbname?sslmode=require",
+					"parseTime=true&loc=UTC&tls=skip-verify",
+					"mongodb://localhost:27017/?tls=true&tlsInsecure=true",
+				} {
+					if !strings.Contains(content, check) {
+						t.Errorf("%s should contain %q", f, check)
+					}
+				}
+			}
+
+			// The local compose containers serve plaintext
+			compose := mfs.FileContent("/output/test-project/docker-compose.yml")
+			if !strings.Contains(compose, "@postgres:5432/dbname?sslmode=disable") {
+				t.Error("docker-compose.yml should keep sslmode=disable for the postgres container")
+			}
+		})
+	}
+}
+
+func TestGenerator_DBSSLModeDiscrete(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	cfg.DBConfigStyle = "discrete"
+	cfg.DBSSLMode = "verify-full"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "DB_SSLMODE=verify-full") {
+		t.Error(".env.example should default DB_SSLMODE to verify-full")
+	}
+
+	cfg.EnvSample = true
+	gen, mfs = createTestGenerator(cfg)
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "\nDB_SSLMODE=verify-full\n") {
+		t.Error("the documented .env.example should default DB_SSLMODE to verify-full")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/config/config.go"), `getEnv("DB_SSLMODE", "verify-full")`) {
+		t.Error("config.go should default DB_SSLMODE to verify-full")
+	}
+}
+
+func TestGenerator_DependencyTimeouts(t *testing.T) {
+	tests := []struct {
+		format       string
+		dbTimeout    string
+		cacheTimeout string
+		configChecks []string
+	}{
+		{"env", "cfg.DBTimeout", "cfg.CacheTimeout", []string{
+			`cfg.DBTimeout = getEnvDuration("DB_TIMEOUT", 5*time.Second)`,
+			`cfg.CacheTimeout = getEnvDuration("CACHE_TIMEOUT", 5*time.Second)`,
+		}},
+		{"yaml", "cfg.GetDBTimeout()", "cfg.GetCacheTimeout()", []string{
+			"Timeout string `yaml:\"timeout\"`",
+			"func (c *Config) GetDBTimeout() time.Duration {",
+			`if timeout := os.Getenv("CACHE_TIMEOUT"); timeout != "" {`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Databases = []string{"postgres", "redis"}
+			cfg.ConfigFormat = tt.format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			for _, check := range tt.configChecks {
+				if !strings.Contains(config, check) {
+					t.Errorf("config.go should contain %q", check)
+				}
+			}
+
+			postgres := mfs.FileContent("/output/test-project/internal/database/postgres.go")
+			if !strings.Contains(postgres, "context.WithTimeout(ctx, "+tt.dbTimeout+")") {
+				t.Errorf("postgres.go should bound the ping with %s", tt.dbTimeout)
+			}
+			redis := mfs.FileContent("/output/test-project/internal/cache/redis.go")
+			if !strings.Contains(redis, "timeout: "+tt.cacheTimeout+"}") {
+				t.Errorf("redis.go should bound cache operations with %s", tt.cacheTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_DockerCustomPort(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.Port = 9000
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	dockerfile := mfs.FileContent("/output/test-project/Dockerfile")
+	for _, check := range []string{"EXPOSE 9000", "ENV PORT=9000", "http://localhost:9000/health"} {
+		if !strings.Contains(dockerfile, check) {
+			t.Errorf("Dockerfile should contain %q", check)
+		}
+	}
+	if strings.Contains(dockerfile, "8080") {
+		t.Error("Dockerfile should not hardcode port 8080")
+	}
+
+	compose := mfs.FileContent("/output/test-project/docker-compose.yml")
+	for _, check := range []string{`"9000:9000"`, "PORT=9000"} {
+		if !strings.Contains(compose, check) {
+			t.Errorf("docker-compose.yml should contain %q", check)
+		}
+	}
+
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if !strings.Contains(config, `getEnv("PORT", "9000")`) {
+		t.Error("config.go should default PORT to 9000")
+	}
+}
+
+func TestGenerator_DockerContainerPort(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.Port = 9000
+	cfg.ContainerPort = 8080
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	dockerfile := mfs.FileContent("/output/test-project/Dockerfile")
+	if !strings.Contains(dockerfile, "EXPOSE 8080") {
+		t.Error("Dockerfile should expose the container port")
+	}
+
+	compose := mfs.FileContent("/output/test-project/docker-compose.yml")
+	for _, check := range []string{`"9000:8080"`, "PORT=8080"} {
+		if !strings.Contains(compose, check) {
+			t.Errorf("docker-compose.yml should contain %q", check)
+		}
+	}
+}
+
+func TestGenerator_DockerfileNonRootUser(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	dockerfile := mfs.FileContent("/output/test-project/Dockerfile")
+	for _, check := range []string{"RUN adduser -D appuser", "COPY --from=builder --chown=appuser:appuser /app/main .", "\nUSER appuser\n"} {
+		if !strings.Contains(dockerfile, check) {
+			t.Errorf("Dockerfile should contain %q", check)
+		}
+	}
+	if strings.Index(dockerfile, "USER appuser") < strings.Index(dockerfile, "FROM alpine") {
+		t.Error("USER should be set in the runtime stage")
+	}
+}
+
+func TestGenerator_Dockerignore(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/.dockerignore")
+	for _, check := range []string{"docs/", "*_test.go", ".github/"} {
+		if !strings.Contains(content, check+"\n") {
+			t.Errorf(".dockerignore should contain %q", check)
+		}
+	}
+	for _, unwanted := range []string{".gitlab-ci.yml", "*.go\n"} {
+		if strings.Contains(content, unwanted) {
+			t.Errorf(".dockerignore should not contain %q", unwanted)
+		}
+	}
+}
+
+func TestGenerator_MakefileRunDocker(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.Port = 9000
+	cfg.ContainerPort = 8080
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	if !strings.Contains(makefile, "run-docker: docker ## ") {
+		t.Error("Makefile run-docker should build the image first")
+	}
+	if !strings.Contains(makefile, "docker run --rm --env-file .env -e PORT=8080 -p 9000:8080 $(BINARY_NAME):latest") {
+		t.Error("Makefile run-docker should run the image with .env, publishing the configured port")
+	}
+}
+
+func TestGenerator_MakefileRunDockerRequiresDocker(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = false
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "--env-file") {
+		t.Error("Makefile should not have run-docker without Docker")
+	}
+}
+
+func TestGenerator_DockerWaitForIt(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.Databases = []string{"postgres", "redis"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	script := mfs.FileContent("/output/test-project/scripts/wait-for-it.sh")
+	if !strings.HasPrefix(script, "#!/bin/sh\n") || !strings.Contains(script, `nc -z "$host" "$port"`) {
+		t.Errorf("wait-for-it.sh should poll addresses with nc, got:\n%s", script)
+	}
+
+	compose := mfs.FileContent("/output/test-project/docker-compose.yml")
+	command := `command: ["sh", "./wait-for-it.sh", "postgres:5432", "redis:6379", "--", "./main"]`
+	if !strings.Contains(compose, command) {
+		t.Errorf("app service should wait for its databases with %q", command)
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/Dockerfile"), "COPY scripts/wait-for-it.sh .") {
+		t.Error("Dockerfile should copy wait-for-it.sh into the runtime image")
+	}
+}
+
+func TestGenerator_DockerWaitForItWithoutDatabases(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/scripts/wait-for-it.sh") {
+		t.Error("wait-for-it.sh should only be generated with databases")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/docker-compose.yml"), "wait-for-it") {
+		t.Error("app service should start directly without databases")
+	}
+}
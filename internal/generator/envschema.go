@@ -0,0 +1,271 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// envSchemaVar is an environment variable listed in the generated EnvSchema.
+type envSchemaVar struct {
+	name     string // Unprefixed name, e.g. PORT
+	typ      string // Generated VarType constant, e.g. TypeInt
+	required bool   // Must be set outside development
+}
+
+// getEnvSchemaVars returns the environment variables the env-format Load
+// reads, in the same order. The backing service settings are required
+// outside development, as their defaults point at localhost.
+func (g *Generator) getEnvSchemaVars() []envSchemaVar {
+	vars := []envSchemaVar{
+		{"ENVIRONMENT", "TypeString", false},
+		{"PORT", "TypeInt", false},
+		{"DRAIN_DELAY", "TypeDuration", false},
+		{"SLOW_REQUEST_THRESHOLD", "TypeDuration", false},
+	}
+
+	if g.config.DiscretePostgresConfig() {
+		for _, f := range postgresDiscreteFields {
+			switch f[0] {
+			case "DB_PORT":
+				vars = append(vars, envSchemaVar{f[0], "TypeInt", false})
+			case "DB_SSLMODE":
+				vars = append(vars, envSchemaVar{f[0], "TypeString", false})
+			default:
+				vars = append(vars, envSchemaVar{f[0], "TypeString", true})
+			}
+		}
+	} else if g.config.HasDatabase("postgres") {
+		vars = append(vars, envSchemaVar{"POSTGRES_URL", "TypeURL", true})
+	}
+	if g.config.HasDatabase("mysql") {
+		// A go-sql-driver DSN such as user:password@tcp(host:3306)/dbname is no URL
+		vars = append(vars, envSchemaVar{"MYSQL_URL", "TypeString", true})
+	}
+	if g.config.HasDatabase("mongodb") {
+		vars = append(vars, envSchemaVar{"MONGO_URL", "TypeURL", true})
+	}
+	if g.config.HasDatabase("redis") {
+		vars = append(vars, envSchemaVar{"REDIS_URL", "TypeURL", true})
+	}
+	if g.needsDatabaseTimeout() {
+		vars = append(vars, envSchemaVar{"DB_TIMEOUT", "TypeDuration", false})
+	}
+	if g.config.NeedsCache() {
+		vars = append(vars, envSchemaVar{"CACHE_TIMEOUT", "TypeDuration", false})
+	}
+	if g.config.EnableTracing {
+		vars = append(vars,
+			envSchemaVar{"OTLP_ENDPOINT", "TypeString", false},
+			envSchemaVar{"SERVICE_NAME", "TypeString", false},
+		)
+		if g.config.OTLPHeaders {
+			vars = append(vars, envSchemaVar{"OTLP_HEADERS", "TypeString", false})
+		}
+	}
+	if g.config.EnableMetrics {
+		vars = append(vars, envSchemaVar{"METRICS_ENABLED", "TypeBool", false})
+	}
+	if g.config.MaxInflight > 0 {
+		vars = append(vars, envSchemaVar{"MAX_INFLIGHT", "TypeInt", false})
+	}
+	if g.config.HTTP2 {
+		vars = append(vars, envSchemaVar{"HTTP2_MAX_CONCURRENT_STREAMS", "TypeInt", false})
+	}
+	if g.config.EnableCORS {
+		vars = append(vars, envSchemaVar{"CORS_ALLOWED_ORIGINS", "TypeString", false})
+	}
+	return vars
+}
+
+// generateEnvSchema writes internal/config/schema.go with the EnvSchema
+// listing the environment variables Load reads, and Check validating them
+// all at once.
+func (g *Generator) generateEnvSchema() error {
+	if !g.config.EnvSchema {
+		return nil
+	}
+
+	var entries strings.Builder
+	for _, v := range g.getEnvSchemaVars() {
+		if v.required {
+			fmt.Fprintf(&entries, "\t{Name: %q, Type: %s, Required: true},\n", g.envVar(v.name), v.typ)
+		} else {
+			fmt.Fprintf(&entries, "\t{Name: %q, Type: %s},\n", g.envVar(v.name), v.typ)
+		}
+	}
+
+	content := fmt.Sprintf(`package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// VarType is the format the value of an environment variable must have.
+type VarType string
+
+const (
+	TypeString   VarType = "string"
+	TypeInt      VarType = "int"
+	TypeBool     VarType = "bool"
+	TypeDuration VarType = "duration"
+	TypeURL      VarType = "url"
+)
+
+// EnvVar describes an environment variable read by Load.
+type EnvVar struct {
+	Name     string
+	Type     VarType
+	Required bool // Must be set outside development, where the localhost defaults do not apply
+}
+
+// EnvSchema lists the environment variables Load reads, besides the
+// FEATURE_* flags.
+var EnvSchema = []EnvVar{
+%s}
+
+// Check validates the environment against EnvSchema. It reports every
+// missing or malformed variable at once rather than stopping at the first,
+// so a deployment can be fixed in one go.
+func Check() error {
+	return check(EnvSchema)
+}
+
+// check validates the environment against schema. Required variables may be
+// unset in development, where the defaults point at the local services.
+func check(schema []EnvVar) error {
+	development := getEnv(%q, "development") == "development"
+
+	var errs []error
+	for _, v := range schema {
+		value := os.Getenv(v.Name)
+		if value == "" {
+			if v.Required && !development {
+				errs = append(errs, fmt.Errorf("%%s is required", v.Name))
+			}
+			continue
+		}
+		if err := v.Type.validate(value); err != nil {
+			errs = append(errs, fmt.Errorf("%%s: %%w", v.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validate returns an error when value does not have the format of t. The
+// error leaves out the value, which may hold credentials.
+func (t VarType) validate(value string) error {
+	switch t {
+	case TypeInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return errors.New("must be an integer")
+		}
+	case TypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be a boolean")
+		}
+	case TypeDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return errors.New("must be a duration (e.g., 5s)")
+		}
+	case TypeURL:
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" {
+			return errors.New("must be a URL")
+		}
+	}
+	return nil
+}
+`, entries.String(), g.envVar("ENVIRONMENT"))
+
+	return g.writeFile("internal/config/schema.go", content)
+}
+
+// getEnvSchemaCheck returns the Load statement running Check once .env is
+// loaded, or "" without --env-schema.
+func (g *Generator) getEnvSchemaCheck() string {
+	if !g.config.EnvSchema {
+		return ""
+	}
+	return `
+	if err := Check(); err != nil {
+		return nil, fmt.Errorf("invalid environment:\n%w", err)
+	}
+`
+}
+
+// generateEnvSchemaTests writes internal/config/schema_test.go testing that
+// Check reports every missing and malformed variable at once.
+func (g *Generator) generateEnvSchemaTests() error {
+	if !g.config.EnvSchema {
+		return nil
+	}
+	content := fmt.Sprintf(`package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_ReportsEveryMissingVar(t *testing.T) {
+	t.Setenv(%[1]q, "production")
+	t.Setenv("TEST_FIRST_URL", "")
+	t.Setenv("TEST_SECOND_URL", "")
+
+	err := check([]EnvVar{
+		{Name: "TEST_FIRST_URL", Type: TypeURL, Required: true},
+		{Name: "TEST_SECOND_URL", Type: TypeURL, Required: true},
+	})
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "TEST_FIRST_URL is required")
+	assert.ErrorContains(t, err, "TEST_SECOND_URL is required")
+}
+
+func TestCheck_AllowsMissingRequiredVarsInDevelopment(t *testing.T) {
+	t.Setenv(%[1]q, "development")
+	t.Setenv("TEST_FIRST_URL", "")
+
+	assert.NoError(t, check([]EnvVar{{Name: "TEST_FIRST_URL", Type: TypeURL, Required: true}}))
+}
+
+func TestCheck_ReportsEveryMalformedVar(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(%[2]q, "eighty")
+	t.Setenv(%[3]q, "soon")
+
+	err := Check()
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "%[2]s: must be an integer")
+	assert.ErrorContains(t, err, "%[3]s: must be a duration")
+}
+`, g.envVar("ENVIRONMENT"), g.envVar("PORT"), g.envVar("DRAIN_DELAY"))
+
+	return g.writeFile("internal/config/schema_test.go", content)
+}
+
+// getEnvSchemaRequiredSetenv returns the test statements setting every
+// required variable, so that Load passes Check outside development.
+func (g *Generator) getEnvSchemaRequiredSetenv() string {
+	if !g.config.EnvSchema {
+		return ""
+	}
+	var sb strings.Builder
+	for _, v := range g.getEnvSchemaVars() {
+		if !v.required {
+			continue
+		}
+		value := "test"
+		if v.typ == "TypeURL" {
+			value = "test://localhost"
+		}
+		fmt.Fprintf(&sb, "\tt.Setenv(%q, %q)\n", g.envVar(v.name), value)
+	}
+	return sb.String()
+}
@@ -0,0 +1,67 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_EnvSchema(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnvSchema = true
+	cfg.Databases = []string{"postgres", "redis"}
+	cfg.EnableMetrics = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	schema := mfs.FileContent("/output/test-project/internal/config/schema.go")
+	for _, entry := range []string{
+		`{Name: "PORT", Type: TypeInt},`,
+		`{Name: "DRAIN_DELAY", Type: TypeDuration},`,
+		`{Name: "POSTGRES_URL", Type: TypeURL, Required: true},`,
+		`{Name: "REDIS_URL", Type: TypeURL, Required: true},`,
+		`{Name: "METRICS_ENABLED", Type: TypeBool},`,
+	} {
+		if !strings.Contains(schema, entry) {
+			t.Errorf("EnvSchema should contain %s", entry)
+		}
+	}
+	if !strings.Contains(schema, "return errors.Join(errs...)") {
+		t.Error("Check should aggregate the errors of every variable")
+	}
+
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if !strings.Contains(config, "_ = godotenv.Load()\n\n\tif err := Check(); err != nil {") {
+		t.Error("Load should run Check once .env is loaded")
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/config/schema_test.go")
+	if !strings.Contains(tests, `assert.ErrorContains(t, err, "TEST_FIRST_URL is required")`) ||
+		!strings.Contains(tests, `assert.ErrorContains(t, err, "TEST_SECOND_URL is required")`) {
+		t.Error("schema_test.go should assert that Check reports every missing variable")
+	}
+
+	// Outside development Load needs the required variables
+	configTests := mfs.FileContent("/output/test-project/internal/config/config_test.go")
+	if !strings.Contains(configTests, `t.Setenv("POSTGRES_URL", "test://localhost")`) {
+		t.Error("TestLoad_EnvOverrides should set the required variables")
+	}
+}
+
+func TestGenerator_EnvSchemaDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/config/schema.go") {
+		t.Error("schema.go should not be generated without --env-schema")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/config/config.go"), "Check()") {
+		t.Error("Load should not run Check without --env-schema")
+	}
+}
@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// exampleRoutes returns the routes of g.routes() that plain HTTP clients can
+// exercise, leaving out the WebSocket upgrade.
+func (g *Generator) exampleRoutes() []route {
+	var routes []route
+	for _, r := range g.routes() {
+		if r.handler != "WebSocket" {
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
+
+// generateExamples writes examples/requests.http for the VS Code REST Client
+// and examples/curl.sh, each exercising every endpoint of g.routes().
+func (g *Generator) generateExamples() error {
+	baseURL := fmt.Sprintf("http://localhost:%d", g.config.AppPort())
+
+	var requests strings.Builder
+	fmt.Fprintf(&requests, "# Example requests for the VS Code REST Client extension.\n\n@baseUrl = %s\n", baseURL)
+	for _, r := range g.exampleRoutes() {
+		fmt.Fprintf(&requests, "\n### %s\n%s {{baseUrl}}%s\n", r.summary, r.method, r.path)
+		if r.example != "" {
+			fmt.Fprintf(&requests, "Content-Type: application/json\n\n%s\n", r.example)
+		}
+	}
+	if err := g.writeFile("examples/requests.http", requests.String()); err != nil {
+		return err
+	}
+
+	var curl strings.Builder
+	fmt.Fprintf(&curl, `#!/bin/sh
+# Example requests against a running %s server.
+# Usage: BASE_URL=%s sh examples/curl.sh
+set -e
+
+BASE_URL="${BASE_URL:-%s}"
+`, g.config.ProjectName, baseURL, baseURL)
+	for _, r := range g.exampleRoutes() {
+		fmt.Fprintf(&curl, "\n# %s\n", r.summary)
+		if r.example != "" {
+			fmt.Fprintf(&curl, "curl -i -X %s \"$BASE_URL%s\" \\\n  -H \"Content-Type: application/json\" \\\n  -d '%s'\n", r.method, r.path, r.example)
+		} else {
+			fmt.Fprintf(&curl, "curl -i \"$BASE_URL%s\"\n", r.path)
+		}
+		curl.WriteString("echo\n")
+	}
+	return g.writeFile("examples/curl.sh", curl.String())
+}
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Examples(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Examples = true
+	cfg.Validator = true
+	cfg.EnableWebSocket = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	curl := mfs.FileContent("/output/test-project/examples/curl.sh")
+	for _, check := range []string{
+		`BASE_URL="${BASE_URL:-http://localhost:8080}"`,
+		`curl -i "$BASE_URL/health"`,
+		`-d '{"name": "Ada Lovelace", "email": "ada@example.com"}'`,
+	} {
+		if !strings.Contains(curl, check) {
+			t.Errorf("curl.sh should contain %q", check)
+		}
+	}
+	if strings.Contains(curl, "/ws") {
+		t.Error("curl.sh should leave out the WebSocket endpoint")
+	}
+
+	requests := mfs.FileContent("/output/test-project/examples/requests.http")
+	for _, check := range []string{
+		"@baseUrl = http://localhost:8080",
+		"### Health check\nGET {{baseUrl}}/health\n",
+		"POST {{baseUrl}}/users\nContent-Type: application/json\n\n{",
+	} {
+		if !strings.Contains(requests, check) {
+			t.Errorf("requests.http should contain %q", check)
+		}
+	}
+}
+
+func TestGenerator_ExamplesDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/examples/curl.sh") {
+		t.Error("examples should only be generated with --examples")
+	}
+}
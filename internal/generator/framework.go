@@ -0,0 +1,157 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// frameworkAdapter describes how the generated app uses a web framework:
+// its dependency, server template, route registrations, and middleware.
+// Adding a framework means implementing one adapter and registering it in
+// frameworkAdapters.
+type frameworkAdapter interface {
+	// Name returns the framework name shown in the generated README.
+	Name() string
+	// Dependency returns the go.mod require line, or "" for net/http.
+	Dependency() string
+	// ServerTemplate returns the embedded template of internal/server/server.go.
+	ServerTemplate() string
+	// RouteRegistrations returns the statements registering routes with the
+	// router, one per route, without indentation.
+	RouteRegistrations(routes []route) []string
+	// MiddlewareSetup returns the framework specific middleware appended to
+	// middleware.go, or "" when the net/http middleware is used as is.
+	MiddlewareSetup() string
+}
+
+// frameworkAdapters maps every supported --framework value to its adapter.
+var frameworkAdapters = map[string]func(g *Generator) frameworkAdapter{
+	"stdlib":   func(g *Generator) frameworkAdapter { return stdlibAdapter{g} },
+	"chi":      func(g *Generator) frameworkAdapter { return chiAdapter{g} },
+	"gin":      func(g *Generator) frameworkAdapter { return ginAdapter{g} },
+	"echo":     func(g *Generator) frameworkAdapter { return echoAdapter{g} },
+	"fiber":    func(g *Generator) frameworkAdapter { return fiberAdapter{g} },
+	"fasthttp": func(g *Generator) frameworkAdapter { return fastHTTPAdapter{g} },
+}
+
+// framework returns the adapter of the configured framework, falling back to
+// net/http when none is set.
+func (g *Generator) framework() frameworkAdapter {
+	if adapter, ok := frameworkAdapters[g.config.Framework]; ok {
+		return adapter(g)
+	}
+	return stdlibAdapter{g}
+}
+
+// registerEach returns the registration of every route, as built by register.
+func registerEach(routes []route, register func(r route, titleMethod string) string) []string {
+	registrations := make([]string, 0, len(routes))
+	for _, r := range routes {
+		registrations = append(registrations, register(r, r.method[:1]+strings.ToLower(r.method[1:])))
+	}
+	return registrations
+}
+
+type stdlibAdapter struct{ g *Generator }
+
+func (stdlibAdapter) Name() string            { return "net/http (standard library)" }
+func (stdlibAdapter) Dependency() string      { return "" }
+func (stdlibAdapter) ServerTemplate() string  { return "server_stdlib.go.tmpl" }
+func (stdlibAdapter) MiddlewareSetup() string { return "" }
+
+func (a stdlibAdapter) RouteRegistrations(routes []route) []string {
+	return registerEach(routes, func(r route, titleMethod string) string {
+		if a.g.checksRouteMethods() {
+			// Before Go 1.22, patterns match any method
+			handler := "handler." + r.handler
+			if r.handler == "Metrics" {
+				handler = "obs.MetricsHandler().ServeHTTP"
+			}
+			return fmt.Sprintf("mux.HandleFunc(%q, handler.AllowMethod(http.Method%s, %s))", r.path, titleMethod, handler)
+		}
+		if r.handler == "Metrics" {
+			return fmt.Sprintf("mux.Handle(%q, obs.MetricsHandler())", r.path)
+		}
+		return fmt.Sprintf("mux.HandleFunc(%q, handler.%s)", r.path, r.handler)
+	})
+}
+
+type chiAdapter struct{ g *Generator }
+
+func (chiAdapter) Name() string              { return "Chi" }
+func (chiAdapter) Dependency() string        { return "github.com/go-chi/chi/v5 v5.0.11" }
+func (chiAdapter) ServerTemplate() string    { return "server_chi.go.tmpl" }
+func (a chiAdapter) MiddlewareSetup() string { return a.g.getChiMiddleware() }
+
+func (chiAdapter) RouteRegistrations(routes []route) []string {
+	return registerEach(routes, func(r route, titleMethod string) string {
+		if r.handler == "Metrics" {
+			return fmt.Sprintf("r.Handle(%q, obs.MetricsHandler())", r.path)
+		}
+		return fmt.Sprintf("r.%s(%q, handler.%s)", titleMethod, r.path, r.handler)
+	})
+}
+
+type ginAdapter struct{ g *Generator }
+
+func (ginAdapter) Name() string              { return "Gin" }
+func (ginAdapter) Dependency() string        { return "github.com/gin-gonic/gin v1.10.0" }
+func (ginAdapter) ServerTemplate() string    { return "server_gin.go.tmpl" }
+func (a ginAdapter) MiddlewareSetup() string { return a.g.getGinMiddleware() }
+
+func (ginAdapter) RouteRegistrations(routes []route) []string {
+	return registerEach(routes, func(r route, _ string) string {
+		if r.handler == "Metrics" {
+			return fmt.Sprintf("r.%s(%q, gin.WrapH(obs.MetricsHandler()))", r.method, r.path)
+		}
+		return fmt.Sprintf("r.%s(%q, handler.%sGin)", r.method, r.path, r.handler)
+	})
+}
+
+type echoAdapter struct{ g *Generator }
+
+func (echoAdapter) Name() string              { return "Echo" }
+func (echoAdapter) Dependency() string        { return "github.com/labstack/echo/v4 v4.11.4" }
+func (echoAdapter) ServerTemplate() string    { return "server_echo.go.tmpl" }
+func (a echoAdapter) MiddlewareSetup() string { return a.g.getEchoMiddleware() }
+
+func (echoAdapter) RouteRegistrations(routes []route) []string {
+	return registerEach(routes, func(r route, _ string) string {
+		if r.handler == "Metrics" {
+			return fmt.Sprintf("s.echo.%s(%q, echo.WrapHandler(obs.MetricsHandler()))", r.method, r.path)
+		}
+		return fmt.Sprintf("s.echo.%s(%q, handler.%sEcho)", r.method, r.path, r.handler)
+	})
+}
+
+type fiberAdapter struct{ g *Generator }
+
+func (fiberAdapter) Name() string              { return "Fiber" }
+func (fiberAdapter) Dependency() string        { return "github.com/gofiber/fiber/v2 v2.52.0" }
+func (fiberAdapter) ServerTemplate() string    { return "server_fiber.go.tmpl" }
+func (a fiberAdapter) MiddlewareSetup() string { return a.g.getFiberMiddleware() }
+
+func (fiberAdapter) RouteRegistrations(routes []route) []string {
+	// Fiber is not net/http based, so even /metrics goes through a handler
+	return registerEach(routes, func(r route, titleMethod string) string {
+		return fmt.Sprintf("s.app.%s(%q, handler.%sFiber)", titleMethod, r.path, r.handler)
+	})
+}
+
+type fastHTTPAdapter struct{ g *Generator }
+
+func (fastHTTPAdapter) Name() string              { return "fasthttp" }
+func (fastHTTPAdapter) Dependency() string        { return "github.com/valyala/fasthttp v1.55.0" }
+func (fastHTTPAdapter) ServerTemplate() string    { return "server_fasthttp.go.tmpl" }
+func (a fastHTTPAdapter) MiddlewareSetup() string { return a.g.getFastHTTPMiddleware() }
+
+func (fastHTTPAdapter) RouteRegistrations(routes []route) []string {
+	// fasthttp dispatches on the path alone, through a map literal
+	width := 0
+	for _, r := range routes {
+		width = max(width, len(r.path)+3)
+	}
+	return registerEach(routes, func(r route, _ string) string {
+		return fmt.Sprintf("%-*s handler.%sFastHTTP,", width, fmt.Sprintf("%q:", r.path), r.handler)
+	})
+}
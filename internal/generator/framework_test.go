@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_FrameworkAdapters(t *testing.T) {
+	for framework := range frameworkAdapters {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.EnableMetrics = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			adapter := gen.framework()
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if _, err := parser.ParseFile(token.NewFileSet(), "server.go", server, parser.AllErrors); err != nil {
+				t.Fatalf("server.go should be valid Go: %v", err)
+			}
+			for _, registration := range adapter.RouteRegistrations(gen.routes()) {
+				if !strings.Contains(server, registration) {
+					t.Errorf("server.go should contain %q", registration)
+				}
+			}
+
+			if dep := adapter.Dependency(); dep != "" && !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), dep) {
+				t.Errorf("go.mod should require %s", dep)
+			}
+			if !strings.Contains(mfs.FileContent("/output/test-project/README.md"), "- **HTTP Framework**: "+adapter.Name()) {
+				t.Errorf("README.md should name the framework %s", adapter.Name())
+			}
+		})
+	}
+}
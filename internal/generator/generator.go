@@ -1,19 +1,42 @@
 package generator
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/anwam/go-template-sh/internal/config"
 	"github.com/anwam/go-template-sh/internal/fsys"
 )
 
+// ManifestFile is written to the root of every generated project, recording
+// the config used so commands such as clean can recognize the project.
+const ManifestFile = ".go-template-sh.json"
+
+// Manifest is the content of ManifestFile: the config used for generation,
+// whose fields it shares so it decodes into a config.Config, along with the
+// generator version and generation time.
+type Manifest struct {
+	*config.Config
+	GeneratorVersion string
+	GeneratedAt      time.Time
+}
+
 // Generator handles the generation of Go project templates.
 type Generator struct {
 	config     *config.Config
 	outputDir  string
 	projectDir string
 	fs         fsys.FileSystem
+	version    string
+
+	// skipExisting keeps files already in projectDir instead of overwriting
+	// them, recording their paths in skipped.
+	skipExisting bool
+	skipped      []string
 }
 
 // Option configures the generator.
@@ -27,6 +50,22 @@ func WithFileSystem(fs fsys.FileSystem) Option {
 	}
 }
 
+// WithVersion sets the generator version recorded in the manifest.
+func WithVersion(version string) Option {
+	return func(g *Generator) {
+		g.version = version
+	}
+}
+
+// WithSkipExisting makes the generator keep files that already exist in the
+// project directory, only adding missing ones. The manifest is always
+// rewritten.
+func WithSkipExisting() Option {
+	return func(g *Generator) {
+		g.skipExisting = true
+	}
+}
+
 // New creates a new Generator with the given configuration and output directory.
 func New(cfg *config.Config, outputDir string, opts ...Option) *Generator {
 	g := &Generator{
@@ -34,6 +73,7 @@ func New(cfg *config.Config, outputDir string, opts ...Option) *Generator {
 		outputDir:  outputDir,
 		projectDir: filepath.Join(outputDir, cfg.ProjectName),
 		fs:         fsys.New(), // Default to OS file system
+		version:    "dev",
 	}
 
 	for _, opt := range opts {
@@ -61,12 +101,31 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	if err := g.generatePprofFile(); err != nil {
+		return err
+	}
+
+	if err := g.generateLogLevelSignalFiles(); err != nil {
+		return err
+	}
+
 	// Only generate the env-based config package if using env format
-	// Other formats (yaml, json, toml) generate their own config.go
+	// Other formats (yaml, json, toml, hcl) generate their own config.go
 	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
 		if err := g.generateConfigPackage(); err != nil {
 			return err
 		}
+		if err := g.generateEnvSchema(); err != nil {
+			return err
+		}
+	}
+
+	if err := g.generateConfigLogFile(); err != nil {
+		return err
+	}
+
+	if err := g.generateConfigDSNFile(); err != nil {
+		return err
 	}
 
 	if err := g.generateServerPackage(); err != nil {
@@ -81,6 +140,24 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	if g.config.Validator {
+		if err := g.generateValidatorPackage(); err != nil {
+			return err
+		}
+	}
+
+	if g.config.ExportMiddleware {
+		if err := g.generateHTTPMWPackage(); err != nil {
+			return err
+		}
+	}
+
+	if g.config.PasswordHash != "" {
+		if err := g.generateHashPackage(); err != nil {
+			return err
+		}
+	}
+
 	if err := g.generateObservability(); err != nil {
 		return err
 	}
@@ -105,6 +182,10 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	if err := g.generateToolsFile(); err != nil {
+		return err
+	}
+
 	if err := g.generateEnvFile(); err != nil {
 		return err
 	}
@@ -119,6 +200,12 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	if g.config.Examples {
+		if err := g.generateExamples(); err != nil {
+			return err
+		}
+	}
+
 	if g.config.IncludeDocker {
 		if err := g.generateDockerFiles(); err != nil {
 			return err
@@ -135,11 +222,61 @@ func (g *Generator) Generate() error {
 		return err
 	}
 
+	if err := g.generateGitattributes(); err != nil {
+		return err
+	}
+
+	if g.config.Procfile {
+		if err := g.generateProcfile(); err != nil {
+			return err
+		}
+	}
+
+	if g.config.VSCode {
+		if err := g.generateVSCodeConfig(); err != nil {
+			return err
+		}
+	}
+
+	if g.config.Nix {
+		if err := g.generateNixFlake(); err != nil {
+			return err
+		}
+	}
+
 	if err := g.generateTestFiles(); err != nil {
 		return err
 	}
 
-	return nil
+	return g.generateManifest()
+}
+
+// generateManifest writes ManifestFile with the config used for generation
+// and the generator version, for reproducibility and the clean command.
+func (g *Generator) generateManifest() error {
+	manifest := Manifest{
+		Config:           g.config,
+		GeneratorVersion: g.version,
+		GeneratedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", ManifestFile, err)
+	}
+	return g.writeFile(ManifestFile, string(content)+"\n")
+}
+
+// ReadManifest reads the ManifestFile of the generated project in projectDir.
+func ReadManifest(projectDir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(projectDir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{Config: &config.Config{}}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	return manifest, nil
 }
 
 func (g *Generator) createDirectoryStructure() error {
@@ -152,6 +289,7 @@ func (g *Generator) createDirectoryStructure() error {
 		filepath.Join(g.projectDir, "internal", "middleware"),
 		filepath.Join(g.projectDir, "internal", "observability"),
 		filepath.Join(g.projectDir, "pkg"),
+		filepath.Join(g.projectDir, "tools"),
 	}
 
 	if g.config.NeedsSQL() || g.config.NeedsNoSQL() {
@@ -178,6 +316,52 @@ func (g *Generator) createDirectoryStructure() error {
 }
 
 func (g *Generator) writeFile(relativePath, content string) error {
+	content = g.stripComments(relativePath, content)
+	if strings.HasSuffix(relativePath, ".go") {
+		content = g.fileHeader() + content
+	}
 	fullPath := filepath.Join(g.projectDir, relativePath)
+	if g.skipExisting && relativePath != ManifestFile {
+		if _, err := g.fs.Stat(fullPath); err == nil {
+			g.skipped = append(g.skipped, relativePath)
+			return nil
+		}
+	}
 	return g.fs.WriteFile(fullPath, []byte(content), 0644)
 }
+
+// SkippedFiles returns the project-relative paths of the existing files kept
+// by WithSkipExisting during Generate.
+func (g *Generator) SkippedFiles() []string {
+	return g.skipped
+}
+
+// fileHeader returns the copyright and license comment prepended to Go files,
+// or an empty string when no author or header is configured.
+func (g *Generator) fileHeader() string {
+	var lines []string
+	if g.config.Author != "" {
+		copyright := fmt.Sprintf("Copyright %d %s", time.Now().Year(), g.config.Author)
+		if g.config.AuthorEmail != "" {
+			copyright += fmt.Sprintf(" <%s>", g.config.AuthorEmail)
+		}
+		lines = append(lines, copyright+".")
+	}
+	if g.config.Header != "" {
+		lines = append(lines, strings.Split(strings.TrimRight(g.config.Header, "\n"), "\n")...)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, line := range lines {
+		if line == "" {
+			sb.WriteString("//\n")
+			continue
+		}
+		sb.WriteString("// " + line + "\n")
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
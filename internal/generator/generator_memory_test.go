@@ -50,6 +50,7 @@ func TestGenerator_WithMemoryFS(t *testing.T) {
 		"Makefile",
 		"README.md",
 		".gitignore",
+		".gitattributes",
 		".env.example",
 	}
 
@@ -187,6 +188,43 @@ func TestGenerator_ServerFileContent_Gin(t *testing.T) {
 	}
 }
 
+func TestGenerator_ServerFileContent_FastHTTP(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "fasthttp"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/server/server.go")
+
+	checks := []string{
+		"package server",
+		"github.com/valyala/fasthttp",
+		"map[string]fasthttp.RequestHandler{",
+		`"/health": handler.HealthFastHTTP,`,
+		"s.server.ShutdownWithContext(ctx)",
+	}
+
+	for _, check := range checks {
+		if !strings.Contains(content, check) {
+			t.Errorf("server.go should contain %q", check)
+		}
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	for _, check := range []string{
+		"func (h *Handler) HealthFastHTTP(ctx *fasthttp.RequestCtx)",
+		"func (h *Handler) ReadyFastHTTP(ctx *fasthttp.RequestCtx)",
+		"func (h *Handler) IndexFastHTTP(ctx *fasthttp.RequestCtx)",
+	} {
+		if !strings.Contains(handlers, check) {
+			t.Errorf("handlers.go should contain %q", check)
+		}
+	}
+}
+
 func TestGenerator_HandlerFileContent(t *testing.T) {
 	cfg := createTestConfig()
 	gen, mfs := createTestGenerator(cfg)
@@ -352,6 +390,40 @@ func TestGenerator_MakefileContent(t *testing.T) {
 	}
 }
 
+func TestGenerator_MakefileCITarget(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/Makefile")
+
+	_, ci, found := strings.Cut(content, "\nci: ## ")
+	if !found {
+		t.Fatal("Makefile should contain a ci: target")
+	}
+	ci, _, _ = strings.Cut(ci, "\n\n")
+	ci += "\n"
+
+	for _, target := range []string{"fmt-check", "vet", "lint", "test", "build"} {
+		if !strings.Contains(ci, "$(MAKE) --no-print-directory "+target+"\n") {
+			t.Errorf("ci target should run %q", target)
+		}
+	}
+	if !strings.Contains(content, "go vet ./...") {
+		t.Error("Makefile vet target should invoke go vet")
+	}
+	if !strings.Contains(content, "go test -v -race") {
+		t.Error("Makefile test target should invoke go test -race")
+	}
+	if !strings.Contains(content, "same checks as .github/workflows/ci.yml") {
+		t.Error("ci target should reference the generated pipeline")
+	}
+}
+
 func TestGenerator_DatabasePostgres(t *testing.T) {
 	cfg := createTestConfig()
 	cfg.Databases = []string{"postgres"}
@@ -474,6 +546,11 @@ func TestGenerator_ConfigFormats(t *testing.T) {
 			expectedFile: "config.toml.example",
 			checks:       []string{"[app]", "port ="},
 		},
+		{
+			format:       "hcl",
+			expectedFile: "config.hcl.example",
+			checks:       []string{"app {", "port "},
+		},
 	}
 
 	for _, tc := range formats {
@@ -502,6 +579,44 @@ cfg := createTestConfig()
 	}
 }
 
+func TestGenerator_EnvPrefix(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.EnvPrefix = "MYAPP"
+			cfg.IncludeDocker = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			wantRead := `os.Getenv("MYAPP_PORT")`
+			if format == "env" {
+				wantRead = `getEnv("MYAPP_PORT", "8080")`
+			}
+			if !strings.Contains(config, wantRead) {
+				t.Errorf("config.go should read MYAPP_PORT, want %s", wantRead)
+			}
+			if strings.Contains(config, `"PORT"`) {
+				t.Error("config.go should not read the unprefixed PORT")
+			}
+
+			env := mfs.FileContent("/output/test-project/.env.example")
+			if !strings.Contains(env, "\nMYAPP_PORT=8080\n") {
+				t.Error(".env.example should document MYAPP_PORT")
+			}
+
+			compose := mfs.FileContent("/output/test-project/docker-compose.yml")
+			if !strings.Contains(compose, "- MYAPP_PORT=8080") {
+				t.Error("docker-compose.yml should set MYAPP_PORT")
+			}
+		})
+	}
+}
+
 func TestGenerator_NoFilesOutsideProjectDir(t *testing.T) {
 	cfg := createTestConfig()
 	cfg.IncludeDocker = true
@@ -520,3 +635,144 @@ func TestGenerator_NoFilesOutsideProjectDir(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerator_Gitattributes(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	attributes := mfs.FileContent("/output/test-project/.gitattributes")
+	for _, check := range []string{"*.go text eol=lf\n", "go.sum linguist-generated\n", "*.exe binary\n"} {
+		if !strings.Contains(attributes, check) {
+			t.Errorf(".gitattributes should contain %q", check)
+		}
+	}
+}
+
+func TestGenerator_EnvExampleSecuritySection(t *testing.T) {
+	for _, cors := range []bool{false, true} {
+		cfg := createTestConfig()
+		cfg.EnvSample = true
+		cfg.EnableCORS = cors
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		env := mfs.FileContent("/output/test-project/.env.example")
+		if got := strings.Contains(env, "# Security Configuration\n"); got != cors {
+			t.Errorf("cors=%v: .env.example security section present = %v", cors, got)
+		}
+		for _, unused := range []string{"JWT_SECRET", "RATE_LIMIT", "EXTERNAL_API_KEY", "WEBHOOK_SECRET"} {
+			if strings.Contains(env, unused) {
+				t.Errorf("cors=%v: .env.example should not mention %s without a feature using it", cors, unused)
+			}
+		}
+	}
+}
+
+func TestGenerator_MajorVersionModulePath(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ModulePath = "github.com/test/test-project/v2"
+	cfg.Databases = []string{"postgres"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if !strings.HasPrefix(mfs.FileContent("/output/test-project/go.mod"), "module github.com/test/test-project/v2\n") {
+		t.Error("go.mod should declare the versioned module path")
+	}
+	checks := map[string]string{
+		"cmd/test-project/main.go":      `"github.com/test/test-project/v2/internal/server"`,
+		"internal/server/server.go":     `"github.com/test/test-project/v2/internal/handlers"`,
+		"internal/database/postgres.go": `"github.com/test/test-project/v2/internal/config"`,
+	}
+	for file, check := range checks {
+		if !strings.Contains(mfs.FileContent("/output/test-project/"+file), check) {
+			t.Errorf("%s should import %s", file, check)
+		}
+	}
+}
+
+func TestGenerator_Procfile(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Procfile = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if got := mfs.FileContent("/output/test-project/Procfile"); got != "web: ./bin/test-project\n" {
+		t.Errorf("Procfile = %q, want the web process running bin/test-project", got)
+	}
+}
+
+func TestGenerator_ProcfileEnvPrefix(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Procfile = true
+	cfg.EnvPrefix = "MYAPP"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if got := mfs.FileContent("/output/test-project/Procfile"); got != "web: MYAPP_PORT=$PORT ./bin/test-project\n" {
+		t.Errorf("Procfile = %q, want $PORT passed on as MYAPP_PORT", got)
+	}
+}
+
+func TestGenerator_ProcfileDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/Procfile") {
+		t.Error("Procfile should only be generated with --procfile")
+	}
+}
+
+func TestGenerator_MainExitCodes(t *testing.T) {
+	for _, logger := range []string{"slog", "zap"} {
+		t.Run(logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = logger
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			content := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+			for _, check := range []string{
+				"exitServerError        = 1",
+				"exitConfigError        = 2",
+				"exitObservabilityError = 3",
+				"return exitConfigError",
+				"return exitObservabilityError",
+				"return exitServerError",
+				"exitCode = exitServerError",
+				"os.Exit(run())",
+				"defer obs.Shutdown(context.WithoutCancel(ctx))",
+			} {
+				if !strings.Contains(content, check) {
+					t.Errorf("main.go should contain %q", check)
+				}
+			}
+			// Any other os.Exit would skip the deferred obs.Shutdown
+			if n := strings.Count(content, "os.Exit("); n != 1 {
+				t.Errorf("main.go should only call os.Exit with run's result, found %d calls", n)
+			}
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package generator
 
 import (
+"encoding/json"
+"fmt"
 "os"
 "path/filepath"
+"reflect"
 "strings"
 "testing"
+"time"
 
 "github.com/anwam/go-template-sh/internal/config"
 )
@@ -421,7 +425,7 @@ func TestGenerator_Generate_WithObservability(t *testing.T) {
 }
 
 func TestGenerator_Generate_AllFrameworks(t *testing.T) {
-	frameworks := []string{"stdlib", "chi", "gin", "echo", "fiber"}
+	frameworks := []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"}
 
 	for _, framework := range frameworks {
 		t.Run(framework, func(t *testing.T) {
@@ -556,6 +560,17 @@ func TestGenerator_buildDependencies_AllOptions(t *testing.T) {
 			},
 			expected: []string{"github.com/gofiber/fiber"},
 		},
+		{
+			name: "fasthttp framework",
+			config: &config.Config{
+				ProjectName:  "test",
+				ModulePath:   "github.com/test/test",
+				GoVersion:    "1.23",
+				Framework:    "fasthttp",
+				ConfigFormat: "env",
+			},
+			expected: []string{"github.com/valyala/fasthttp"},
+		},
 		{
 			name: "zerolog logger",
 			config: &config.Config{
@@ -620,6 +635,16 @@ func TestGenerator_buildDependencies_AllOptions(t *testing.T) {
 			},
 			expected: []string{"github.com/BurntSushi/toml"},
 		},
+		{
+			name: "hcl config format",
+			config: &config.Config{
+				ProjectName:  "test",
+				ModulePath:   "github.com/test/test",
+				GoVersion:    "1.23",
+				ConfigFormat: "hcl",
+			},
+			expected: []string{"github.com/hashicorp/hcl/v2"},
+		},
 		{
 			name: "tracing enabled",
 			config: &config.Config{
@@ -766,3 +791,97 @@ func TestGenerator_writeFile_CreatesSubdirectories(t *testing.T) {
 		t.Error("Expected nested file to exist")
 	}
 }
+
+func TestGenerator_writeFile_Header(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Author = "Example Corp"
+	cfg.AuthorEmail = "dev@example.com"
+	cfg.Header = "SPDX-License-Identifier: MIT"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	want := fmt.Sprintf("// Copyright %d Example Corp <dev@example.com>.\n// SPDX-License-Identifier: MIT\n\npackage main\n", time.Now().Year())
+	mainGo := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	if !strings.HasPrefix(mainGo, want) {
+		t.Errorf("main.go should start with the header, got:\n%s", mainGo[:min(len(mainGo), 200)])
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "Copyright") {
+		t.Error("non-Go files should not get the header")
+	}
+}
+
+func TestGenerator_writeFile_NoHeader(t *testing.T) {
+	gen, mfs := createTestGenerator(createTestConfig())
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	mainGo := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	if !strings.HasPrefix(mainGo, "package main") {
+		t.Error("main.go should start with the package clause when no header is configured")
+	}
+}
+
+func TestGenerator_Manifest(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	cfg.EnvPrefix = "MYAPP"
+	gen, mfs := createTestGenerator(cfg)
+	WithVersion("v1.2.3")(gen)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/" + ManifestFile)
+
+	var got config.Config
+	if err := json.Unmarshal([]byte(content), &got); err != nil {
+		t.Fatalf("%s should decode into config.Config: %v", ManifestFile, err)
+	}
+	if !reflect.DeepEqual(&got, cfg) {
+		t.Errorf("%s config = %+v, want %+v", ManifestFile, got, *cfg)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal([]byte(content), &manifest); err != nil {
+		t.Fatalf("%s should decode into Manifest: %v", ManifestFile, err)
+	}
+	if manifest.GeneratorVersion != "v1.2.3" {
+		t.Errorf("GeneratorVersion = %q, want %q", manifest.GeneratorVersion, "v1.2.3")
+	}
+	if manifest.GeneratedAt.IsZero() {
+		t.Error("GeneratedAt should record the generation time")
+	}
+}
+
+func TestGenerator_SkipExisting(t *testing.T) {
+	cfg := createTestConfig()
+	mfs := createMemoryFS()
+	if err := mfs.WriteFile("/output/test-project/README.md", []byte("# My notes\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gen := New(cfg, "/output", WithFileSystem(mfs), WithSkipExisting())
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if got := mfs.FileContent("/output/test-project/README.md"); got != "# My notes\n" {
+		t.Errorf("existing README.md should be kept, got:\n%s", got)
+	}
+	if !mfs.HasFile("/output/test-project/go.mod") {
+		t.Error("missing files should still be generated")
+	}
+	if !mfs.HasFile("/output/test-project/" + ManifestFile) {
+		t.Errorf("%s should always be written", ManifestFile)
+	}
+	if skipped := gen.SkippedFiles(); len(skipped) != 1 || skipped[0] != "README.md" {
+		t.Errorf("SkippedFiles() = %v, want [README.md]", skipped)
+	}
+}
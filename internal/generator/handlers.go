@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -14,6 +15,7 @@ func (g *Generator) getHandlersContent() string {
 	imports := []string{
 		`"encoding/json"`,
 		`"net/http"`,
+		`"sync/atomic"`,
 		fmt.Sprintf(`"%s/internal/config"`, g.config.ModulePath),
 		fmt.Sprintf(`"%s/internal/observability"`, g.config.ModulePath),
 	}
@@ -27,14 +29,34 @@ func (g *Generator) getHandlersContent() string {
 		if g.config.EnableMetrics {
 			imports = append(imports, `"github.com/gofiber/fiber/v2/middleware/adaptor"`)
 		}
+	} else if g.config.Framework == "fasthttp" {
+		imports = append(imports, `"github.com/valyala/fasthttp"`)
+		if g.config.EnableMetrics {
+			imports = append(imports, `"github.com/valyala/fasthttp/fasthttpadaptor"`)
+		}
 	}
 
-	if g.config.EnableMetrics {
+	// The net/http routers serve obs.MetricsHandler() directly
+	if g.config.EnableMetrics && g.config.Framework != "stdlib" && g.config.Framework != "chi" {
 		imports = append(imports, `"github.com/prometheus/client_golang/prometheus/promhttp"`)
 	}
 
-	frameworkHandlers := g.getFrameworkSpecificHandlers()
-	envRef := g.getConfigFieldReference("Environment")
+	if g.config.Validator {
+		imports = append(imports, fmt.Sprintf(`"%s/pkg/validate"`, g.config.ModulePath))
+	}
+
+	imports = append(imports, g.getContentNegotiationImports()...)
+	imports = append(imports, g.getHandlerTracingImports()...)
+	imports = append(imports, g.getHealthDetailImports()...)
+	imports = append(imports, g.getWebSocketImports()...)
+	for _, imp := range g.getCachedImports() {
+		// context and time may already be imported by other endpoints
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+
+	frameworkHandlers := g.getFrameworkSpecificHandlers() + g.getHandlerTracer() + g.getHealthDetailHandlers() + g.getValidatorHandlers() + g.getWebSocketHandlers() + g.getCachedHandlers() + g.getMethodNotAllowedHandlers()
 
 	return fmt.Sprintf(`package handlers
 
@@ -45,22 +67,44 @@ import (
 type Handler struct {
 	config *config.Config
 	obs    *observability.Observability
-}
+	ready  atomic.Bool
+%s}
 
 func NewHandler(cfg *config.Config, obs *observability.Observability) *Handler {
+	// Readiness starts false: main flips it once every dependency is connected
 	return &Handler{
 		config: cfg,
 		obs:    obs,
 	}
 }
 
+// SetReady controls whether the Ready handler reports the service as ready.
+// main sets it once startup succeeded and clears it during shutdown so load
+// balancers stop routing traffic.
+func (h *Handler) SetReady(ready bool) {
+	h.ready.Store(ready)
+}
+
 type Response struct {
 	Status  string                 `+"`json:\"status\"`"+`
 	Message string                 `+"`json:\"message,omitempty\"`"+`
 	Data    map[string]interface{} `+"`json:\"data,omitempty\"`"+`
 }
 
-func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
+var notReadyResponse = Response{
+	Status:  "unavailable",
+	Message: "Service is not accepting traffic",
+}
+
+%s
+%s
+`, strings.Join(imports, "\n\t"), g.getCachedHandlerField(), g.getBaseHandlers(), frameworkHandlers)
+}
+
+// getBaseHandlers returns the net/http Health, Ready, and Index handlers,
+// writing JSON or, with --content-negotiation, the format Accept asks for.
+func (g *Generator) getBaseHandlers() string {
+	handlers := `func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(Response{
 		Status:  "ok",
@@ -70,6 +114,11 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	if !h.ready.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(notReadyResponse)
+		return
+	}
 	json.NewEncoder(w).Encode(Response{
 		Status:  "ready",
 		Message: "Service is ready to accept traffic",
@@ -77,7 +126,7 @@ func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
+%s	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(Response{
 		Status:  "ok",
 		Message: "Welcome to %s",
@@ -87,9 +136,39 @@ func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
 		},
 	})
 }
+`
+	if g.config.ContentNegotiation {
+		handlers = `func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusOK, Response{
+		Status:  "ok",
+		Message: "Service is healthy",
+	})
+}
 
-%s
-`, strings.Join(imports, "\n\t"), g.config.ProjectName, envRef, frameworkHandlers)
+func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
+	if !h.ready.Load() {
+		respond(w, r, http.StatusServiceUnavailable, notReadyResponse)
+		return
+	}
+	respond(w, r, http.StatusOK, Response{
+		Status:  "ready",
+		Message: "Service is ready to accept traffic",
+	})
+}
+
+func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
+%s	respond(w, r, http.StatusOK, Response{
+		Status:  "ok",
+		Message: "Welcome to %s",
+		Data: map[string]interface{}{
+			"version":     "1.0.0",
+			"environment": %s,
+		},
+	})
+}
+`
+	}
+	return fmt.Sprintf(handlers, g.getIndexSpan("r.Context()"), g.config.ProjectName, g.getHandlerConfigReference("Environment")) + g.getRespondFunc()
 }
 
 func (g *Generator) getFrameworkSpecificHandlers() string {
@@ -100,6 +179,8 @@ func (g *Generator) getFrameworkSpecificHandlers() string {
 		return g.getEchoHandlers()
 	case "fiber":
 		return g.getFiberHandlers()
+	case "fasthttp":
+		return g.getFastHTTPHandlers()
 	default:
 		return ""
 	}
@@ -114,7 +195,7 @@ func (h *Handler) MetricsGin(c *gin.Context) {
 }`
 	}
 
-	envRef := g.getConfigFieldReference("Environment")
+	envRef := g.getHandlerConfigReference("Environment")
 
 	return fmt.Sprintf(`func (h *Handler) HealthGin(c *gin.Context) {
 	c.JSON(http.StatusOK, Response{
@@ -124,6 +205,10 @@ func (h *Handler) MetricsGin(c *gin.Context) {
 }
 
 func (h *Handler) ReadyGin(c *gin.Context) {
+	if !h.ready.Load() {
+		c.JSON(http.StatusServiceUnavailable, notReadyResponse)
+		return
+	}
 	c.JSON(http.StatusOK, Response{
 		Status:  "ready",
 		Message: "Service is ready to accept traffic",
@@ -131,7 +216,7 @@ func (h *Handler) ReadyGin(c *gin.Context) {
 }
 
 func (h *Handler) IndexGin(c *gin.Context) {
-	c.JSON(http.StatusOK, Response{
+%s	c.JSON(http.StatusOK, Response{
 		Status:  "ok",
 		Message: "Welcome to %s",
 		Data: map[string]interface{}{
@@ -140,7 +225,7 @@ func (h *Handler) IndexGin(c *gin.Context) {
 		},
 	})
 }
-%s`, g.config.ProjectName, envRef, metricsHandler)
+%s`, g.getIndexSpan("c.Request.Context()"), g.config.ProjectName, envRef, metricsHandler)
 }
 
 func (g *Generator) getEchoHandlers() string {
@@ -153,7 +238,7 @@ func (h *Handler) MetricsEcho(c echo.Context) error {
 }`
 	}
 
-	envRef := g.getConfigFieldReference("Environment")
+	envRef := g.getHandlerConfigReference("Environment")
 
 	return fmt.Sprintf(`func (h *Handler) HealthEcho(c echo.Context) error {
 	return c.JSON(http.StatusOK, Response{
@@ -163,6 +248,9 @@ func (h *Handler) MetricsEcho(c echo.Context) error {
 }
 
 func (h *Handler) ReadyEcho(c echo.Context) error {
+	if !h.ready.Load() {
+		return c.JSON(http.StatusServiceUnavailable, notReadyResponse)
+	}
 	return c.JSON(http.StatusOK, Response{
 		Status:  "ready",
 		Message: "Service is ready to accept traffic",
@@ -170,7 +258,7 @@ func (h *Handler) ReadyEcho(c echo.Context) error {
 }
 
 func (h *Handler) IndexEcho(c echo.Context) error {
-	return c.JSON(http.StatusOK, Response{
+%s	return c.JSON(http.StatusOK, Response{
 		Status:  "ok",
 		Message: "Welcome to %s",
 		Data: map[string]interface{}{
@@ -179,7 +267,7 @@ func (h *Handler) IndexEcho(c echo.Context) error {
 		},
 	})
 }
-%s`, g.config.ProjectName, envRef, metricsHandler)
+%s`, g.getIndexSpan("c.Request().Context()"), g.config.ProjectName, envRef, metricsHandler)
 }
 
 func (g *Generator) getFiberHandlers() string {
@@ -192,7 +280,7 @@ func (h *Handler) MetricsFiber(c *fiber.Ctx) error {
 }`
 	}
 
-	envRef := g.getConfigFieldReference("Environment")
+	envRef := g.getHandlerConfigReference("Environment")
 
 	return fmt.Sprintf(`func (h *Handler) HealthFiber(c *fiber.Ctx) error {
 	return c.JSON(Response{
@@ -202,6 +290,9 @@ func (h *Handler) MetricsFiber(c *fiber.Ctx) error {
 }
 
 func (h *Handler) ReadyFiber(c *fiber.Ctx) error {
+	if !h.ready.Load() {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(notReadyResponse)
+	}
 	return c.JSON(Response{
 		Status:  "ready",
 		Message: "Service is ready to accept traffic",
@@ -209,7 +300,56 @@ func (h *Handler) ReadyFiber(c *fiber.Ctx) error {
 }
 
 func (h *Handler) IndexFiber(c *fiber.Ctx) error {
-	return c.JSON(Response{
+%s	return c.JSON(Response{
+		Status:  "ok",
+		Message: "Welcome to %s",
+		Data: map[string]interface{}{
+			"version":     "1.0.0",
+			"environment": %s,
+		},
+	})
+}
+%s`, g.getIndexSpan("fiberTraceContext(c)"), g.config.ProjectName, envRef, metricsHandler)
+}
+
+func (g *Generator) getFastHTTPHandlers() string {
+	metricsHandler := ""
+	if g.config.EnableMetrics {
+		metricsHandler = `
+func (h *Handler) MetricsFastHTTP(ctx *fasthttp.RequestCtx) {
+	// Use fasthttpadaptor to serve the net/http promhttp handler
+	fasthttpadaptor.NewFastHTTPHandler(promhttp.Handler())(ctx)
+}`
+	}
+
+	envRef := g.getHandlerConfigReference("Environment")
+
+	return fmt.Sprintf(`func writeFastHTTPJSON(ctx *fasthttp.RequestCtx, status int, v interface{}) {
+	ctx.SetContentType("application/json")
+	ctx.SetStatusCode(status)
+	json.NewEncoder(ctx).Encode(v)
+}
+
+func (h *Handler) HealthFastHTTP(ctx *fasthttp.RequestCtx) {
+	writeFastHTTPJSON(ctx, fasthttp.StatusOK, Response{
+		Status:  "ok",
+		Message: "Service is healthy",
+	})
+}
+
+func (h *Handler) ReadyFastHTTP(ctx *fasthttp.RequestCtx) {
+	if !h.ready.Load() {
+		writeFastHTTPJSON(ctx, fasthttp.StatusServiceUnavailable, notReadyResponse)
+		return
+	}
+	writeFastHTTPJSON(ctx, fasthttp.StatusOK, Response{
+		Status:  "ready",
+		Message: "Service is ready to accept traffic",
+	})
+}
+
+func (h *Handler) IndexFastHTTP(ctx *fasthttp.RequestCtx) {
+%s	writeFastHTTPJSON(ctx, fasthttp.StatusOK, Response{
 		Status:  "ok",
 		Message: "Welcome to %s",
 		Data: map[string]interface{}{
@@ -218,5 +358,78 @@ func (h *Handler) IndexFiber(c *fiber.Ctx) error {
 		},
 	})
 }
-%s`, g.config.ProjectName, envRef, metricsHandler)
+%s`, g.getIndexSpan("fastHTTPTraceContext(ctx)"), g.config.ProjectName, envRef, metricsHandler)
+}
+
+// getHandlerConfigReference returns the reference to a config field from a
+// Handler method, which reaches the config through h.config.
+func (g *Generator) getHandlerConfigReference(field string) string {
+	return "h.config" + strings.TrimPrefix(g.getConfigFieldReference(field), "cfg")
+}
+
+// getHandlerTracingImports returns the handlers.go imports of the manual
+// instrumentation example, or nil when tracing is disabled.
+func (g *Generator) getHandlerTracingImports() []string {
+	if !g.config.EnableTracing {
+		return nil
+	}
+	imports := []string{`"go.opentelemetry.io/otel"`, `"go.opentelemetry.io/otel/attribute"`}
+	if g.config.Framework == "fiber" || g.config.Framework == "fasthttp" {
+		imports = append(imports, `"context"`)
+	}
+	return imports
+}
+
+// getIndexSpan returns the statements starting a child span of the request
+// span in ctx at the top of the Index handlers, demonstrating manual
+// instrumentation, or "" when tracing is disabled.
+func (g *Generator) getIndexSpan(ctx string) string {
+	if !g.config.EnableTracing {
+		return ""
+	}
+	return fmt.Sprintf(`	// Manual instrumentation example: a child span of the request span
+	_, span := tracer.Start(%s, "index")
+	defer span.End()
+	span.SetAttributes(attribute.String("app.environment", %s))
+
+`, ctx, g.getHandlerConfigReference("Environment"))
+}
+
+// getHandlerTracer returns the tracer of the handlers package and, for fiber
+// and fasthttp, the helper reading the request span's context stored by the
+// tracing middleware, or "" when tracing is disabled.
+func (g *Generator) getHandlerTracer() string {
+	if !g.config.EnableTracing {
+		return ""
+	}
+
+	code := `
+// tracer creates the spans of the handlers package.
+var tracer = otel.Tracer("handlers")
+`
+	switch g.config.Framework {
+	case "fiber":
+		code += `
+// fiberTraceContext returns the request span's context stored by
+// FiberTracing, or context.Background() without one.
+func fiberTraceContext(c *fiber.Ctx) context.Context {
+	if ctx, ok := c.Locals("trace_ctx").(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+`
+	case "fasthttp":
+		code += `
+// fastHTTPTraceContext returns the request span's context stored by
+// FastHTTPTracing, or context.Background() without one.
+func fastHTTPTraceContext(ctx *fasthttp.RequestCtx) context.Context {
+	if spanCtx, ok := ctx.UserValue("trace_ctx").(context.Context); ok {
+		return spanCtx
+	}
+	return context.Background()
+}
+`
+	}
+	return code
 }
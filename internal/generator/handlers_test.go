@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerator_ReadinessDrain(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	for _, check := range []string{
+		"ready  atomic.Bool",
+		"func (h *Handler) SetReady(ready bool)",
+		"if !h.ready.Load()",
+		"http.StatusServiceUnavailable",
+	} {
+		if !strings.Contains(handlers, check) {
+			t.Errorf("handlers.go should contain %q", check)
+		}
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/handlers/handlers_test.go")
+	if !strings.Contains(tests, "suite.handler.SetReady(false)") ||
+		!strings.Contains(tests, "suite.Equal(http.StatusServiceUnavailable, w.Code)") {
+		t.Error("handlers_test.go should assert /ready returns 503 after drain begins")
+	}
+
+	main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	for _, check := range []string{"srv.SetReady(false)", "cfg.DrainDelay", "time.Sleep(drainDelay)"} {
+		if !strings.Contains(main, check) {
+			t.Errorf("main.go should contain %q", check)
+		}
+	}
+}
+
+func TestGenerator_DrainDelayConfig(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.DrainDelay = 5 * time.Second
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if !strings.Contains(content, `getEnvDuration("DRAIN_DELAY", 5000 * time.Millisecond)`) {
+		t.Errorf("config.go should default DRAIN_DELAY to the configured delay, got:\n%s", content)
+	}
+
+	env := mfs.FileContent("/output/test-project/.env.example")
+	if !strings.Contains(env, "DRAIN_DELAY=5s") {
+		t.Error(".env.example should document DRAIN_DELAY")
+	}
+}
+
+func TestGenerator_IndexEnvironmentTest(t *testing.T) {
+	for _, format := range []string{"env", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			tests := mfs.FileContent("/output/test-project/internal/handlers/handlers_test.go")
+			if !strings.Contains(tests, "func TestIndexEnvironment(t *testing.T) {") {
+				t.Error("handlers_test.go should contain TestIndexEnvironment")
+			}
+			if !strings.Contains(tests, `assert.Equal(t, tt.environment, response.Data["environment"])`) {
+				t.Error("TestIndexEnvironment should assert the reported environment")
+			}
+
+			envRef := "h.config.Environment"
+			if format != "env" {
+				envRef = "h.config.GetEnvironment()"
+			}
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			if !strings.Contains(handlers, `"environment": `+envRef+",") {
+				t.Errorf("Index should report the environment from %s", envRef)
+			}
+		})
+	}
+}
+
+func TestGenerator_IndexSpan(t *testing.T) {
+	tests := []struct {
+		framework string
+		start     string
+	}{
+		{"stdlib", `_, span := tracer.Start(r.Context(), "index")`},
+		{"gin", `_, span := tracer.Start(c.Request.Context(), "index")`},
+		{"echo", `_, span := tracer.Start(c.Request().Context(), "index")`},
+		{"fiber", `_, span := tracer.Start(fiberTraceContext(c), "index")`},
+		{"fasthttp", `_, span := tracer.Start(fastHTTPTraceContext(ctx), "index")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.EnableTracing = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			for _, check := range []string{tt.start, `var tracer = otel.Tracer("handlers")`, "span.SetAttributes(attribute.String("} {
+				if !strings.Contains(handlers, check) {
+					t.Errorf("handlers.go should contain %q", check)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_IndexSpanWithoutTracing(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/handlers/handlers.go"), "tracer.Start") {
+		t.Error("handlers.go should only start spans with tracing enabled")
+	}
+}
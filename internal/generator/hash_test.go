@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_PasswordHash(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		checks    []string
+	}{
+		{"bcrypt", []string{`"golang.org/x/crypto/bcrypt"`, "bcrypt.GenerateFromPassword("}},
+		{"argon2", []string{`"golang.org/x/crypto/argon2"`, "argon2.IDKey(", "subtle.ConstantTimeCompare("}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.PasswordHash = tt.algorithm
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			path := "/output/test-project/pkg/hash/hash.go"
+			if !mfs.HasFile(path) {
+				t.Fatal("Expected pkg/hash/hash.go to exist")
+			}
+			content := mfs.FileContent(path)
+			checks := append([]string{
+				"package hash",
+				"func HashPassword(password string) (string, error) {",
+				"func CheckPassword(password, hash string) bool {",
+			}, tt.checks...)
+			for _, check := range checks {
+				if !strings.Contains(content, check) {
+					t.Errorf("hash.go should contain %q", check)
+				}
+			}
+
+			test := mfs.FileContent("/output/test-project/pkg/hash/hash_test.go")
+			if !strings.Contains(test, "func TestHashPassword_RoundTrip(t *testing.T) {") {
+				t.Error("hash_test.go should test the hash round trip")
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "golang.org/x/crypto") {
+				t.Error("go.mod should require golang.org/x/crypto")
+			}
+		})
+	}
+}
+
+func TestGenerator_PasswordHashDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/pkg/hash/hash.go") {
+		t.Error("pkg/hash should only be generated with a password hash algorithm")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "golang.org/x/crypto") {
+		t.Error("go.mod should not require golang.org/x/crypto without password hashing")
+	}
+}
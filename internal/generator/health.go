@@ -0,0 +1,90 @@
+package generator
+
+// getHealthDetailImports returns the handlers.go imports of the detailed
+// health endpoint, or nil when it is disabled.
+func (g *Generator) getHealthDetailImports() []string {
+	if !g.config.HealthDetail {
+		return nil
+	}
+	return []string{`"runtime"`, `"runtime/debug"`, `"time"`}
+}
+
+// getHealthDetailHandlers returns the /healthz/detailed handler reporting
+// uptime, Go version, and build info for the configured framework, or ""
+// when the endpoint is disabled.
+func (g *Generator) getHealthDetailHandlers() string {
+	if !g.config.HealthDetail {
+		return ""
+	}
+
+	handler := ""
+	switch g.config.Framework {
+	case "gin":
+		handler = `
+func (h *Handler) HealthDetailedGin(c *gin.Context) {
+	c.JSON(http.StatusOK, detailedHealthResponse())
+}
+`
+	case "echo":
+		handler = `
+func (h *Handler) HealthDetailedEcho(c echo.Context) error {
+	return c.JSON(http.StatusOK, detailedHealthResponse())
+}
+`
+	case "fiber":
+		handler = `
+func (h *Handler) HealthDetailedFiber(c *fiber.Ctx) error {
+	return c.JSON(detailedHealthResponse())
+}
+`
+	case "fasthttp":
+		handler = `
+func (h *Handler) HealthDetailedFastHTTP(ctx *fasthttp.RequestCtx) {
+	writeFastHTTPJSON(ctx, fasthttp.StatusOK, detailedHealthResponse())
+}
+`
+	default:
+		handler = `
+func (h *Handler) HealthDetailed(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(detailedHealthResponse())
+}
+`
+		if g.config.ContentNegotiation {
+			handler = `
+func (h *Handler) HealthDetailed(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusOK, detailedHealthResponse())
+}
+`
+		}
+	}
+
+	return `
+// startTime is when the process started, for the uptime health detail.
+var startTime = time.Now()
+
+// detailedHealthResponse returns the health response with the uptime, Go
+// version, and build info of the running binary.
+func detailedHealthResponse() Response {
+	data := map[string]interface{}{
+		"uptime":     time.Since(startTime).Round(time.Second).String(),
+		"go_version": runtime.Version(),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		build := map[string]string{"version": info.Main.Version}
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision", "vcs.time", "vcs.modified":
+				build[s.Key] = s.Value
+			}
+		}
+		data["build"] = build
+	}
+	return Response{
+		Status:  "ok",
+		Message: "Service is healthy",
+		Data:    data,
+	}
+}
+` + handler
+}
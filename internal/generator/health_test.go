@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_HealthDetail(t *testing.T) {
+	tests := []struct {
+		framework string
+		route     string
+	}{
+		{"stdlib", `mux.HandleFunc("/healthz/detailed", handler.HealthDetailed)`},
+		{"chi", `r.Get("/healthz/detailed", handler.HealthDetailed)`},
+		{"gin", `r.GET("/healthz/detailed", handler.HealthDetailedGin)`},
+		{"echo", `s.echo.GET("/healthz/detailed", handler.HealthDetailedEcho)`},
+		{"fiber", `s.app.Get("/healthz/detailed", handler.HealthDetailedFiber)`},
+		{"fasthttp", `"/healthz/detailed": handler.HealthDetailedFastHTTP,`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.HealthDetail = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.route) {
+				t.Errorf("server.go should register the detailed health route with %q", tt.route)
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			for _, check := range []string{
+				`"uptime":     time.Since(startTime).Round(time.Second).String(),`,
+				`"go_version": runtime.Version(),`,
+				"debug.ReadBuildInfo()",
+			} {
+				if !strings.Contains(handlers, check) {
+					t.Errorf("handlers.go should contain %q", check)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_HealthDetailDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "/healthz/detailed") {
+		t.Error("server.go should not register /healthz/detailed unless enabled")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/handlers/handlers.go"), "uptime") {
+		t.Error("handlers.go should not report uptime unless enabled")
+	}
+}
@@ -0,0 +1,111 @@
+package generator
+
+import "fmt"
+
+// getMaxConcurrentStreamsRef returns the config reference of the HTTP/2
+// streams-per-connection cap the server applies, or "" without --http2.
+func (g *Generator) getMaxConcurrentStreamsRef() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return g.getConfigFieldReference("HTTP2MaxConcurrentStreams")
+}
+
+// getMaxConcurrentStreamsConfigField returns the env-format Config field
+// holding the HTTP/2 streams-per-connection cap.
+func (g *Generator) getMaxConcurrentStreamsConfigField() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return `
+	// HTTP2MaxConcurrentStreams caps the HTTP/2 streams per connection.
+	// Zero keeps the x/net/http2 default of 250.
+	HTTP2MaxConcurrentStreams int
+`
+}
+
+// getMaxConcurrentStreamsLoadStatement returns the env-format Load statement
+// reading HTTP2_MAX_CONCURRENT_STREAMS, defaulting to the
+// --http2-max-concurrent-streams value.
+func (g *Generator) getMaxConcurrentStreamsLoadStatement() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return fmt.Sprintf("\n\tcfg.HTTP2MaxConcurrentStreams = getEnvInt(%q, %d)\n", g.envVar("HTTP2_MAX_CONCURRENT_STREAMS"), g.config.MaxConcurrentStreams)
+}
+
+// getMaxConcurrentStreamsStructField returns the AppConfig field of a
+// structured config holding the HTTP/2 streams-per-connection cap, tagged
+// for the given format. It is too long to align with the other AppConfig
+// fields, so it starts its own block.
+func (g *Generator) getMaxConcurrentStreamsStructField(tag string) string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return fmt.Sprintf("\n\tHTTP2MaxConcurrentStreams int %s\n", fieldTag(tag, "http2_max_concurrent_streams"))
+}
+
+// getMaxConcurrentStreamsEnvFallback returns the loadFromEnv AppConfig entry
+// setting the --http2-max-concurrent-streams default, which
+// HTTP2_MAX_CONCURRENT_STREAMS then overrides.
+func (g *Generator) getMaxConcurrentStreamsEnvFallback() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return fmt.Sprintf("\n\n\t\t\tHTTP2MaxConcurrentStreams: %d,", g.config.MaxConcurrentStreams)
+}
+
+// getMaxConcurrentStreamsEnvOverride returns the applyEnvOverrides statement
+// letting HTTP2_MAX_CONCURRENT_STREAMS override the config file's cap.
+func (g *Generator) getMaxConcurrentStreamsEnvOverride() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return fmt.Sprintf(`
+	if maxStreams := os.Getenv(%q); maxStreams != "" {
+		fmt.Sscanf(maxStreams, "%%d", &c.App.HTTP2MaxConcurrentStreams)
+	}`, g.envVar("HTTP2_MAX_CONCURRENT_STREAMS"))
+}
+
+// getMaxConcurrentStreamsAccessor returns the GetHTTP2MaxConcurrentStreams
+// accessor of a structured config.
+func (g *Generator) getMaxConcurrentStreamsAccessor() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return `
+// GetHTTP2MaxConcurrentStreams returns the cap on HTTP/2 streams per
+// connection, 0 for the x/net/http2 default
+func (c *Config) GetHTTP2MaxConcurrentStreams() int {
+	return c.App.HTTP2MaxConcurrentStreams
+}
+`
+}
+
+// getMaxConcurrentStreamsValidation returns the validate check rejecting a
+// negative stream cap, which would wrap around when converted to uint32.
+func (g *Generator) getMaxConcurrentStreamsValidation() string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
+		return fmt.Sprintf(`
+	if c.HTTP2MaxConcurrentStreams < 0 {
+		return fmt.Errorf("%s must not be negative")
+	}`, g.envVar("HTTP2_MAX_CONCURRENT_STREAMS"))
+	}
+	return `
+	if c.App.HTTP2MaxConcurrentStreams < 0 {
+		return fmt.Errorf("app.http2_max_concurrent_streams must not be negative")
+	}`
+}
+
+// getMaxConcurrentStreamsExample returns line, formatted with the
+// --http2-max-concurrent-streams value, for the app section of a config file
+// example, or "" without --http2.
+func (g *Generator) getMaxConcurrentStreamsExample(line string) string {
+	if !g.config.HTTP2 {
+		return ""
+	}
+	return fmt.Sprintf(line, g.config.MaxConcurrentStreams)
+}
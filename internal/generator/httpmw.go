@@ -0,0 +1,179 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// generateHTTPMWPackage writes pkg/httpmw, the exported net/http middleware
+// subset (RequestID, Logger, Recoverer) other modules can import. Its API
+// takes no logger type, so it does not change with the configured logger.
+func (g *Generator) generateHTTPMWPackage() error {
+	imports := []string{`"context"`, `"net/http"`, `"time"`}
+	if g.config.EnableWebSocket {
+		imports = append(imports, `"bufio"`, `"net"`)
+	}
+	if g.config.DisableUUID {
+		imports = append(imports, `"crypto/rand"`, `"encoding/hex"`)
+	} else {
+		imports = append(imports, `"github.com/google/uuid"`)
+	}
+
+	content := fmt.Sprintf(`// Package httpmw provides net/http middleware shared across services:
+// request IDs, request logging, and panic recovery. It does not depend on a
+// logging library; adapt your logger with a LogFunc.
+package httpmw
+
+import (
+	%s
+)
+
+// Middleware wraps an http.Handler with additional behavior.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h with middlewares so that the first one listed is outermost:
+// Chain(h, a, b) serves each request through a, then b, then h.
+func Chain(h http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+type contextKey string
+
+// RequestIDKey is the context key holding the request ID set by RequestID.
+const RequestIDKey contextKey = "requestID"
+
+// RequestIDFromContext returns the request ID set by RequestID, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestIDKey).(string)
+	return requestID
+}
+
+%s
+// RequestID propagates the X-Request-ID header, generating one with
+// NewRequestID when missing, and stores it in the request context.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = NewRequestID()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+		ctx := context.WithValue(r.Context(), RequestIDKey, requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// LogFunc records a served request with its response status, response size
+// in bytes, and duration.
+type LogFunc func(r *http.Request, status, bytes int, duration time.Duration)
+
+// Logger calls log after serving every request.
+func Logger(next http.Handler, log LogFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rr := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rr, r)
+		log(r, rr.status, rr.bytes, time.Since(start))
+	})
+}
+
+// Recoverer answers requests whose handler panics with a 500.
+func Recoverer(next http.Handler) http.Handler {
+	return RecovererWith(nil)(next)
+}
+
+// RecovererWith returns a Recoverer that calls onPanic, when not nil, for
+// every recovered panic before answering it, e.g. to count panics.
+func RecovererWith(onPanic func()) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if err := recover(); err != nil {
+					if onPanic != nil {
+						onPanic()
+					}
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// extend the write deadline.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+%s`, strings.Join(imports, "\n\t"), g.getNewRequestIDFunc(), g.getResponseRecorderHijack())
+
+	return g.writeFile("pkg/httpmw/httpmw.go", content)
+}
+
+// getExportedStandardMiddleware returns the internal net/http middleware
+// when the subset is exported to pkg/httpmw: Logger adapts the configured
+// logger to an httpmw.LogFunc, and Metrics keeps its own response recorder.
+func (g *Generator) getExportedStandardMiddleware(loggerType, loggerImpl string) string {
+	loggerImpl = strings.NewReplacer("rr.status", "status", "rr.bytes", "bytes").Replace(loggerImpl)
+	loggerImpl = "\t" + strings.ReplaceAll(loggerImpl, "\n", "\n\t")
+	if g.usesAccessLogLine() {
+		loggerImpl = "\t\tstart := time.Now().Add(-duration)\n" + loggerImpl
+	}
+
+	return fmt.Sprintf(`// Middleware wraps an http.Handler with additional behavior.
+type Middleware = httpmw.Middleware
+
+// Logger logs every request through httpmw.Logger and additionally warns
+// about requests slower than slowThreshold. A zero threshold disables the
+// slow request warning.
+func Logger(next http.Handler, logger %s, slowThreshold time.Duration) http.Handler {
+	return httpmw.Logger(next, func(r *http.Request, status, bytes int, duration time.Duration) {
+%s
+%s
+	})
+}
+%s
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// extend the write deadline.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+%s`, loggerType, loggerImpl, g.getSlowRequestLog("\t", "r.Method", "r.URL.Path"), g.getMetricsMiddleware(), g.getResponseRecorderHijack())
+}
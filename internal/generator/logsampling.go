@@ -0,0 +1,109 @@
+package generator
+
+// Every logger samples alike with --log-sampling: per second, the first 100
+// entries are logged, then every 100th, the rates of zap's production config.
+
+// getSlogSamplingHandler returns the expression NewLogger builds its logger
+// on: handler itself, or handler wrapped in the sampler with --log-sampling.
+func (g *Generator) getSlogSamplingHandler() string {
+	if !g.config.LogSampling {
+		return "handler"
+	}
+	return "newSamplingHandler(handler)"
+}
+
+// getSlogSamplingFuncs returns the slog handler sampling debug and info
+// records, or "" without --log-sampling. slog has no sampling of its own.
+func (g *Generator) getSlogSamplingFuncs() string {
+	if !g.config.LogSampling {
+		return ""
+	}
+	return `
+// Per second, the first logSampleInitial debug and info records are logged,
+// then every logSampleThereafter-th.
+const (
+	logSampleInitial    = 100
+	logSampleThereafter = 100
+)
+
+// samplingHandler samples debug and info records to bound the log volume of
+// busy services. Warnings and errors are always logged.
+type samplingHandler struct {
+	slog.Handler
+	sampler *logSampler
+}
+
+func newSamplingHandler(h slog.Handler) *samplingHandler {
+	return &samplingHandler{Handler: h, sampler: &logSampler{}}
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < slog.LevelWarn && !h.sampler.sample(r.Time) {
+		return nil
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs and WithGroup share the sampler, so derived loggers count
+// against the same per-second budget.
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{Handler: h.Handler.WithAttrs(attrs), sampler: h.sampler}
+}
+
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{Handler: h.Handler.WithGroup(name), sampler: h.sampler}
+}
+
+// logSampler counts the records of the current one-second window.
+type logSampler struct {
+	mu     sync.Mutex
+	window time.Time
+	count  int
+}
+
+// sample reports whether the record logged at t is kept.
+func (s *logSampler) sample(t time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.Sub(s.window) >= time.Second {
+		s.window = t
+		s.count = 0
+	}
+	s.count++
+	return s.count <= logSampleInitial || (s.count-logSampleInitial)%logSampleThereafter == 0
+}
+`
+}
+
+// getZapSamplingConfig returns the NewZapLogger statement sampling entries
+// in every environment, or "" without --log-sampling, leaving zap's defaults:
+// sampling in production only.
+func (g *Generator) getZapSamplingConfig() string {
+	if !g.config.LogSampling {
+		return ""
+	}
+	return `
+	// Per second, log the first 100 entries with the same level and message,
+	// then every 100th.
+	zapConfig.Sampling = &zap.SamplingConfig{Initial: 100, Thereafter: 100}
+`
+}
+
+// getZerologSampling returns the NewZerologLogger statements sampling debug
+// and info events, or "" without --log-sampling.
+func (g *Generator) getZerologSampling() string {
+	if !g.config.LogSampling {
+		return ""
+	}
+	return `
+	// Per second, log the first 100 debug and info events, then every 100th.
+	// Warnings and errors are always logged.
+	sampler := &zerolog.BurstSampler{
+		Burst:       100,
+		Period:      time.Second,
+		NextSampler: &zerolog.BasicSampler{N: 100},
+	}
+	logger = logger.Sample(zerolog.LevelSampler{DebugSampler: sampler, InfoSampler: sampler})
+`
+}
@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_LogSampling(t *testing.T) {
+	tests := []struct {
+		logger string
+		want   []string
+	}{
+		{"zap", []string{
+			"zapConfig.Sampling = &zap.SamplingConfig{Initial: 100, Thereafter: 100}\n\n\treturn zapConfig.Build()",
+		}},
+		{"zerolog", []string{
+			"NextSampler: &zerolog.BasicSampler{N: 100},",
+			"logger = logger.Sample(zerolog.LevelSampler{DebugSampler: sampler, InfoSampler: sampler})",
+		}},
+		{"slog", []string{
+			"\t\"context\"\n\t\"log/slog\"\n\t\"os\"\n\t\"sync\"\n\t\"time\"\n",
+			"return slog.New(newSamplingHandler(handler))",
+			"if r.Level < slog.LevelWarn && !h.sampler.sample(r.Time) {",
+			"return &samplingHandler{Handler: h.Handler.WithAttrs(attrs), sampler: h.sampler}",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = tt.logger
+			cfg.LogSampling = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			logger := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+			for _, want := range tt.want {
+				if !strings.Contains(logger, want) {
+					t.Errorf("logger.go should contain %q", want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_LogSamplingWithOtelLogs(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableTracing = true
+	cfg.OtelLogs = true
+	cfg.LogSampling = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	logger := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+	if strings.Count(logger, "\t\"context\"\n") != 1 {
+		t.Error("logger.go should import context once")
+	}
+}
+
+func TestGenerator_LogSamplingDisabled(t *testing.T) {
+	for _, logger := range []string{"slog", "zap", "zerolog"} {
+		t.Run(logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = logger
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			content := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+			for _, unwanted := range []string{"Sampling", "Sampler", "sampler"} {
+				if strings.Contains(content, unwanted) {
+					t.Errorf("logger.go should not contain %q without --log-sampling", unwanted)
+				}
+			}
+		})
+	}
+}
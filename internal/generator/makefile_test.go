@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MakefileHelp(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.IncludeDocker = true
+	cfg.SBOM = true
+	cfg.BuildTags = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	if !strings.Contains(makefile, "\nhelp: ## Show this help\n") {
+		t.Error("Makefile should have a self-documenting help target")
+	}
+	if !strings.Contains(makefile, "@grep -E '^[a-zA-Z_-]+:.*## ' $(MAKEFILE_LIST)") {
+		t.Error("make help should list the ##-annotated targets")
+	}
+
+	targets := regexp.MustCompile(`(?m)^[a-z-]+:.*$`).FindAllString(makefile, -1)
+	if len(targets) == 0 {
+		t.Fatal("Makefile should define targets")
+	}
+	for _, target := range targets {
+		if !strings.Contains(target, " ## ") {
+			t.Errorf("target %q should have a ## description", target)
+		}
+	}
+}
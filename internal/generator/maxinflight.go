@@ -0,0 +1,250 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getMaxInflightMiddleware returns the in-flight request limiter for the
+// configured framework, or "" without --max-inflight. The limiter is a
+// buffered channel used as a semaphore: a request holds a slot while it is
+// served, and requests arriving while every slot is held get a 503.
+func (g *Generator) getMaxInflightMiddleware() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+
+	switch g.config.Framework {
+	case "gin":
+		return `
+// GinMaxInflight serves at most n requests at once and answers 503 to the
+// requests arriving beyond that. n <= 0 disables the limit.
+func GinMaxInflight(n int) gin.HandlerFunc {
+	if n <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	sem := make(chan struct{}, n)
+	return func(c *gin.Context) {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+			c.Next()
+		default:
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+		}
+	}
+}
+`
+	case "fiber":
+		return `
+// FiberMaxInflight serves at most n requests at once and answers 503 to the
+// requests arriving beyond that. n <= 0 disables the limit.
+func FiberMaxInflight(n int) fiber.Handler {
+	if n <= 0 {
+		return func(c *fiber.Ctx) error { return c.Next() }
+	}
+	sem := make(chan struct{}, n)
+	return func(c *fiber.Ctx) error {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+			return c.Next()
+		default:
+			return c.SendStatus(fiber.StatusServiceUnavailable)
+		}
+	}
+}
+`
+	case "fasthttp":
+		return `
+// FastHTTPMaxInflight serves at most n requests at once and answers 503 to
+// the requests arriving beyond that. n <= 0 disables the limit.
+func FastHTTPMaxInflight(next fasthttp.RequestHandler, n int) fasthttp.RequestHandler {
+	if n <= 0 {
+		return next
+	}
+	sem := make(chan struct{}, n)
+	return func(ctx *fasthttp.RequestCtx) {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+			next(ctx)
+		default:
+			ctx.Error(fasthttp.StatusMessage(fasthttp.StatusServiceUnavailable), fasthttp.StatusServiceUnavailable)
+		}
+	}
+}
+`
+	default:
+		// net/http middleware, also used by chi and, wrapped, by echo
+		return `
+// MaxInflight serves at most n requests at once and answers 503 to the
+// requests arriving beyond that. n <= 0 disables the limit.
+func MaxInflight(n int) Middleware {
+	return func(next http.Handler) http.Handler {
+		if n <= 0 {
+			return next
+		}
+		sem := make(chan struct{}, n)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				next.ServeHTTP(w, r)
+			default:
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+			}
+		})
+	}
+}
+`
+	}
+}
+
+// getMaxInflightRef returns the config reference of the in-flight request
+// cap the server applies, or "" without --max-inflight.
+func (g *Generator) getMaxInflightRef() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return g.getConfigFieldReference("MaxInflight")
+}
+
+// getMaxInflightConfigField returns the env-format Config field holding the
+// in-flight request cap.
+func (g *Generator) getMaxInflightConfigField() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return `
+	// MaxInflight caps the requests served at once; the server answers 503
+	// beyond it. Zero or less disables the limit.
+	MaxInflight int
+`
+}
+
+// getMaxInflightLoadStatement returns the env-format Load statement reading
+// MAX_INFLIGHT, defaulting to the --max-inflight value.
+func (g *Generator) getMaxInflightLoadStatement() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\tcfg.MaxInflight = getEnvInt(%q, %d)\n", g.envVar("MAX_INFLIGHT"), g.config.MaxInflight)
+}
+
+// getEnvIntFunc returns the env-format getEnvInt helper reading MAX_INFLIGHT
+// and HTTP2_MAX_CONCURRENT_STREAMS.
+func (g *Generator) getEnvIntFunc() string {
+	if g.config.MaxInflight == 0 && !g.config.HTTP2 {
+		return ""
+	}
+	return `
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		n, err := strconv.Atoi(value)
+		if err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
+`
+}
+
+// getMaxInflightStructField returns the AppConfig field of a structured
+// config holding the in-flight request cap, tagged for the given format and
+// aligned with the other AppConfig fields.
+func (g *Generator) getMaxInflightStructField(tag string) string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\tMaxInflight          int    %s\n", fieldTag(tag, "max_inflight"))
+}
+
+// getMaxInflightEnvFallback returns the loadFromEnv AppConfig entry setting
+// the --max-inflight default, which MAX_INFLIGHT then overrides.
+func (g *Generator) getMaxInflightEnvFallback() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\t\t\tMaxInflight:          %d,", g.config.MaxInflight)
+}
+
+// getMaxInflightEnvOverride returns the applyEnvOverrides statement letting
+// MAX_INFLIGHT override the config file's in-flight request cap.
+func (g *Generator) getMaxInflightEnvOverride() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`
+	if maxInflight := os.Getenv(%q); maxInflight != "" {
+		fmt.Sscanf(maxInflight, "%%d", &c.App.MaxInflight)
+	}`, g.envVar("MAX_INFLIGHT"))
+}
+
+// getMaxInflightAccessor returns the GetMaxInflight accessor of a structured
+// config.
+func (g *Generator) getMaxInflightAccessor() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return `
+// GetMaxInflight returns the cap on requests served at once, 0 or less for none
+func (c *Config) GetMaxInflight() int {
+	return c.App.MaxInflight
+}
+`
+}
+
+// getMaxInflightMiddlewareTests returns the generated tests of the net/http
+// MaxInflight middleware, holding the only slot to check that the next
+// request is rejected.
+func (g *Generator) getMaxInflightMiddlewareTests() string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return `
+func TestMaxInflight_RejectsOverflowWith503(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	h := MaxInflight(1)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+	}))
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/", nil))
+	}()
+	<-entered
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	close(release)
+	<-done
+	assert.Equal(t, http.StatusOK, first.Code)
+}
+
+func TestMaxInflight_ReleasesSlotAfterRequest(t *testing.T) {
+	h := MaxInflight(1)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+`
+}
+
+// getMaxInflightExample returns line, formatted with the --max-inflight
+// value, for the app section of a config file example, or "" without it.
+func (g *Generator) getMaxInflightExample(line string) string {
+	if g.config.MaxInflight == 0 {
+		return ""
+	}
+	return fmt.Sprintf(line, g.config.MaxInflight)
+}
@@ -0,0 +1,141 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MaxInflight(t *testing.T) {
+	tests := []struct {
+		framework  string
+		limiter    string
+		rejection  string
+		registered string
+	}{
+		{"stdlib", "func MaxInflight(n int) Middleware {", "http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)", "middleware.MaxInflight(cfg.MaxInflight),"},
+		{"chi", "func MaxInflight(n int) Middleware {", "http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)", "r.Use(custommw.MaxInflight(cfg.MaxInflight))"},
+		{"gin", "func GinMaxInflight(n int) gin.HandlerFunc {", "c.AbortWithStatus(http.StatusServiceUnavailable)", "r.Use(middleware.GinMaxInflight(cfg.MaxInflight))"},
+		{"echo", "func MaxInflight(n int) Middleware {", "http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)", "s.echo.Use(echo.WrapMiddleware(custommw.MaxInflight(cfg.MaxInflight)))"},
+		{"fiber", "func FiberMaxInflight(n int) fiber.Handler {", "return c.SendStatus(fiber.StatusServiceUnavailable)", "s.app.Use(middleware.FiberMaxInflight(cfg.MaxInflight))"},
+		{"fasthttp", "func FastHTTPMaxInflight(next fasthttp.RequestHandler, n int) fasthttp.RequestHandler {", "fasthttp.StatusServiceUnavailable)", "h = middleware.FastHTTPMaxInflight(h, cfg.MaxInflight)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.MaxInflight = 64
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			if !strings.Contains(middleware, tt.limiter) {
+				t.Errorf("middleware.go should define %q", tt.limiter)
+			}
+			if !strings.Contains(middleware, "sem := make(chan struct{}, n)") {
+				t.Error("the limiter should use a buffered channel as a semaphore")
+			}
+			if !strings.Contains(middleware, "case sem <- struct{}{}:\n\t\t\tdefer func() { <-sem }()") &&
+				!strings.Contains(middleware, "case sem <- struct{}{}:\n\t\t\t\tdefer func() { <-sem }()") {
+				t.Error("the limiter should release its slot once the request is served")
+			}
+			if !strings.Contains(middleware, tt.rejection) {
+				t.Errorf("the limiter should reject overflow with 503 via %q", tt.rejection)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.registered) {
+				t.Errorf("server.go should register the limiter with %q", tt.registered)
+			}
+		})
+	}
+}
+
+func TestGenerator_MaxInflightConfig(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		cfg := createTestConfig()
+		cfg.MaxInflight = 64
+		cfg.EnvPrefix = "MYAPP"
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		config := mfs.FileContent("/output/test-project/internal/config/config.go")
+		if !strings.Contains(config, `cfg.MaxInflight = getEnvInt("MYAPP_MAX_INFLIGHT", 64)`) {
+			t.Error("config.go should read MYAPP_MAX_INFLIGHT, defaulting to --max-inflight")
+		}
+		if !strings.Contains(config, "func getEnvInt(key string, defaultValue int) int {") {
+			t.Error("config.go should define getEnvInt")
+		}
+
+		env := mfs.FileContent("/output/test-project/.env.example")
+		if !strings.Contains(env, "\nMYAPP_MAX_INFLIGHT=64\n") {
+			t.Error(".env.example should document MYAPP_MAX_INFLIGHT")
+		}
+
+		tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+		if !strings.Contains(tests, "func TestMaxInflight_RejectsOverflowWith503(t *testing.T) {") {
+			t.Error("middleware_test.go should test that overflow requests get a 503")
+		}
+	})
+
+	for _, format := range []string{"yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.MaxInflight = 64
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, "MaxInflight          int    ") {
+				t.Error("AppConfig should hold MaxInflight")
+			}
+			if !strings.Contains(config, `fmt.Sscanf(maxInflight, "%d", &c.App.MaxInflight)`) {
+				t.Error("MAX_INFLIGHT should override the config file")
+			}
+			if !strings.Contains(config, "func (c *Config) GetMaxInflight() int {") {
+				t.Error("config.go should define GetMaxInflight")
+			}
+
+			example := mfs.FileContent("/output/test-project/config." + format + ".example")
+			if !strings.Contains(example, "max_inflight") || !strings.Contains(example, "64") {
+				t.Errorf("config.%s.example should set max_inflight", format)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, "middleware.MaxInflight(cfg.GetMaxInflight())") {
+				t.Error("server.go should read the cap through GetMaxInflight")
+			}
+		})
+	}
+}
+
+func TestGenerator_MaxInflightDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if strings.Contains(middleware, "MaxInflight") {
+		t.Error("middleware.go should not define a limiter without --max-inflight")
+	}
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if strings.Contains(config, "MaxInflight") || strings.Contains(config, "getEnvInt") {
+		t.Error("config.go should not read MAX_INFLIGHT without --max-inflight")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "MAX_INFLIGHT") {
+		t.Error(".env.example should not document MAX_INFLIGHT without --max-inflight")
+	}
+}
@@ -0,0 +1,76 @@
+package generator
+
+// checksRouteMethods reports whether the net/http server wraps its routes
+// with AllowMethod: before Go 1.22, ServeMux patterns match any method.
+func (g *Generator) checksRouteMethods() bool {
+	return g.framework().ServerTemplate() == "server_stdlib.go.tmpl" && g.config.GoVersion == "1.21"
+}
+
+// getMethodNotAllowedHandlers returns the handlers answering requests for a
+// route with another method with a JSON 405, or "" for frameworks keeping
+// their own response: fiber, fasthttp, and net/http from Go 1.22.
+func (g *Generator) getMethodNotAllowedHandlers() string {
+	switch g.config.Framework {
+	case "gin":
+		return `
+// MethodNotAllowedGin answers requests for a route with another method, in
+// the JSON of the other error responses.
+func (h *Handler) MethodNotAllowedGin(c *gin.Context) {
+	c.JSON(http.StatusMethodNotAllowed, Response{Status: "error", Message: "method not allowed"})
+}
+`
+	case "echo":
+		return `
+// MethodNotAllowedEcho answers requests for a route with another method, in
+// the JSON of the other error responses.
+func (h *Handler) MethodNotAllowedEcho(c echo.Context) error {
+	return c.JSON(http.StatusMethodNotAllowed, Response{Status: "error", Message: "method not allowed"})
+}
+`
+	case "chi":
+	default:
+		if !g.checksRouteMethods() {
+			return ""
+		}
+	}
+
+	handler := `
+// MethodNotAllowed answers requests for a route with another method, in the
+// JSON of the other error responses.
+func (h *Handler) MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	json.NewEncoder(w).Encode(Response{Status: "error", Message: "method not allowed"})
+}
+`
+	if g.config.ContentNegotiation {
+		handler = `
+// MethodNotAllowed answers requests for a route with another method, in the
+// format of the other error responses.
+func (h *Handler) MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	respond(w, r, http.StatusMethodNotAllowed, Response{Status: "error", Message: "method not allowed"})
+}
+`
+	}
+	if g.checksRouteMethods() {
+		handler += `
+// AllowMethod serves the requests with the given method, and HEAD requests
+// along with GET, answering the others with MethodNotAllowed.
+func (h *Handler) AllowMethod(method string, next http.HandlerFunc) http.HandlerFunc {
+	allow := method
+	if method == http.MethodGet {
+		allow += ", " + http.MethodHead
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method && (method != http.MethodGet || r.Method != http.MethodHead) {
+			w.Header().Set("Allow", allow)
+			h.MethodNotAllowed(w, r)
+			return
+		}
+		next(w, r)
+	}
+}
+`
+	}
+	return handler
+}
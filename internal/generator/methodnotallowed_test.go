@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MethodNotAllowed(t *testing.T) {
+	tests := []struct {
+		framework string
+		handler   string
+		server    []string
+	}{
+		{"chi", "func (h *Handler) MethodNotAllowed(w http.ResponseWriter, r *http.Request) {", []string{"r.MethodNotAllowed(handler.MethodNotAllowed)"}},
+		{"gin", "func (h *Handler) MethodNotAllowedGin(c *gin.Context) {", []string{"r.HandleMethodNotAllowed = true", "r.NoMethod(handler.MethodNotAllowedGin)"}},
+		{"echo", "func (h *Handler) MethodNotAllowedEcho(c echo.Context) error {", []string{"he.Code == http.StatusMethodNotAllowed", "_ = handler.MethodNotAllowedEcho(c)", "s.echo.DefaultHTTPErrorHandler(err, c)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			if !strings.Contains(handlers, tt.handler) {
+				t.Errorf("handlers.go should define %q", tt.handler)
+			}
+			if !strings.Contains(handlers, `Response{Status: "error", Message: "method not allowed"}`) {
+				t.Error("the 405 body should be a JSON Response like the other errors")
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			for _, want := range tt.server {
+				if !strings.Contains(server, want) {
+					t.Errorf("server.go should contain %q", want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_MethodNotAllowedStdlib(t *testing.T) {
+	t.Run("go 1.21", func(t *testing.T) {
+		cfg := createTestConfig()
+		cfg.GoVersion = "1.21"
+		cfg.EnableMetrics = true
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+		if !strings.Contains(handlers, "func (h *Handler) AllowMethod(method string, next http.HandlerFunc) http.HandlerFunc {") {
+			t.Error("handlers.go should define the AllowMethod wrapper")
+		}
+
+		server := mfs.FileContent("/output/test-project/internal/server/server.go")
+		for _, want := range []string{
+			`mux.HandleFunc("/health", handler.AllowMethod(http.MethodGet, handler.Health))`,
+			`mux.HandleFunc("/metrics", handler.AllowMethod(http.MethodGet, obs.MetricsHandler().ServeHTTP))`,
+		} {
+			if !strings.Contains(server, want) {
+				t.Errorf("server.go should contain %q", want)
+			}
+		}
+	})
+
+	t.Run("go 1.22 and later", func(t *testing.T) {
+		cfg := createTestConfig()
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		if strings.Contains(mfs.FileContent("/output/test-project/internal/handlers/handlers.go"), "MethodNotAllowed") {
+			t.Error("handlers.go should not define MethodNotAllowed without the Go 1.21 wrapper")
+		}
+		if !strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), `mux.HandleFunc("/health", handler.Health)`) {
+			t.Error("server.go should register the handlers as is")
+		}
+	})
+}
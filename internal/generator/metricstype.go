@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultSummaryObjectives are the latency quantiles of --metrics-type
+// summary without --metrics-objectives.
+var defaultSummaryObjectives = []float64{0.5, 0.9, 0.99}
+
+// usesSummaryMetrics reports whether request latency is recorded in a
+// Summary with quantile objectives rather than a Histogram.
+func (g *Generator) usesSummaryMetrics() bool {
+	return g.config.MetricsType == "summary"
+}
+
+// getSummaryObjectives returns the quantiles of the latency Summary.
+func (g *Generator) getSummaryObjectives() []float64 {
+	if len(g.config.MetricsObjectives) > 0 {
+		return g.config.MetricsObjectives
+	}
+	return defaultSummaryObjectives
+}
+
+// getRequestDurationField returns the Observability field recording request
+// latency.
+func (g *Generator) getRequestDurationField() string {
+	if g.usesSummaryMetrics() {
+		return "\thttpRequestDuration  *prometheus.SummaryVec"
+	}
+	return "\thttpRequestDuration  *prometheus.HistogramVec"
+}
+
+// getRequestDurationInit returns the registration of the request latency
+// metric: a Histogram over the default buckets, or a Summary tracking each
+// objective quantile within a tenth of its distance to 1 (0.9 within 0.01).
+func (g *Generator) getRequestDurationInit() string {
+	if !g.usesSummaryMetrics() {
+		return fmt.Sprintf(`	obs.httpRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+%s
+		},
+		[]string{"method", "endpoint"},
+	)`, g.getMetricOpts(
+			[2]string{"Name", `"http_request_duration_seconds"`},
+			[2]string{"Help", `"HTTP request duration in seconds"`},
+			[2]string{"Buckets", "prometheus.DefBuckets"},
+		))
+	}
+
+	objectives := make([]string, 0, len(g.getSummaryObjectives()))
+	for _, q := range g.getSummaryObjectives() {
+		objectives = append(objectives, fmt.Sprintf("%s: %s", formatQuantile(q), strconv.FormatFloat((1-q)/10, 'g', 3, 64)))
+	}
+
+	return fmt.Sprintf(`	obs.httpRequestDuration = promauto.NewSummaryVec(
+		prometheus.SummaryOpts{
+%s
+		},
+		[]string{"method", "endpoint"},
+	)`, g.getMetricOpts(
+		[2]string{"Name", `"http_request_duration_seconds"`},
+		[2]string{"Help", `"HTTP request duration in seconds"`},
+		[2]string{"Objectives", fmt.Sprintf("map[float64]float64{%s}", strings.Join(objectives, ", "))},
+	))
+}
+
+// getMetricsTypeDescription returns how the README describes the latency
+// metric, e.g. "a summary with the 0.5, 0.9, and 0.99 quantiles", or "" for
+// the default histogram.
+func (g *Generator) getMetricsTypeDescription() string {
+	if !g.usesSummaryMetrics() {
+		return ""
+	}
+
+	quantiles := make([]string, 0, len(g.getSummaryObjectives()))
+	for _, q := range g.getSummaryObjectives() {
+		quantiles = append(quantiles, formatQuantile(q))
+	}
+	switch len(quantiles) {
+	case 1:
+		return "a summary with the " + quantiles[0] + " quantile"
+	case 2:
+		return "a summary with the " + quantiles[0] + " and " + quantiles[1] + " quantiles"
+	default:
+		last := len(quantiles) - 1
+		return "a summary with the " + strings.Join(quantiles[:last], ", ") + ", and " + quantiles[last] + " quantiles"
+	}
+}
+
+func formatQuantile(q float64) string {
+	return strconv.FormatFloat(q, 'g', -1, 64)
+}
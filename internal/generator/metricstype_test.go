@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MetricsTypeSummary(t *testing.T) {
+	tests := []struct {
+		name       string
+		objectives []float64
+		want       string
+		readme     string
+	}{
+		{"default objectives", nil, "Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},", "a summary with the 0.5, 0.9, and 0.99 quantiles"},
+		{"custom objectives", []float64{0.95, 0.999}, "Objectives: map[float64]float64{0.95: 0.005, 0.999: 0.0001},", "a summary with the 0.95 and 0.999 quantiles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.EnableMetrics = true
+			cfg.MetricsType = "summary"
+			cfg.MetricsObjectives = tt.objectives
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			obs := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+			for _, want := range []string{
+				"httpRequestDuration  *prometheus.SummaryVec",
+				"obs.httpRequestDuration = promauto.NewSummaryVec(\n\t\tprometheus.SummaryOpts{",
+				tt.want,
+			} {
+				if !strings.Contains(obs, want) {
+					t.Errorf("observability.go should contain %q", want)
+				}
+			}
+			if strings.Contains(obs, "prometheus.DefBuckets") {
+				t.Error("the latency summary should not have histogram buckets")
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/README.md"), tt.readme) {
+				t.Errorf("README.md should describe %q", tt.readme)
+			}
+		})
+	}
+}
+
+func TestGenerator_MetricsTypeHistogramByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableMetrics = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	obs := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+	if !strings.Contains(obs, "httpRequestDuration  *prometheus.HistogramVec") || strings.Contains(obs, "SummaryVec") {
+		t.Error("request latency should be a histogram without --metrics-type summary")
+	}
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -12,10 +13,13 @@ func (g *Generator) generateMiddleware() error {
 
 func (g *Generator) getMiddlewareContent() string {
 	imports := []string{
-		`"context"`,
 		`"net/http"`,
 		`"time"`,
 	}
+	// traceID reads the span of a context.Context, and RouteTimeout derives one
+	if !g.config.ExportMiddleware || (g.config.TraceIDHeader != "" && g.config.EnableTracing) || len(g.config.RouteTimeouts) > 0 {
+		imports = append([]string{`"context"`}, imports...)
+	}
 
 	loggerType := "interface{}"
 	switch g.config.Logger {
@@ -30,40 +34,82 @@ func (g *Generator) getMiddlewareContent() string {
 		loggerType = "*zerolog.Logger"
 	}
 
-	if g.config.Framework == "gin" {
+	if g.config.Framework == "chi" {
+		imports = append(imports, `"github.com/go-chi/chi/v5/middleware"`)
+	} else if g.config.Framework == "gin" {
 		imports = append(imports, `"github.com/gin-gonic/gin"`)
 	} else if g.config.Framework == "echo" {
 		imports = append(imports, `"github.com/labstack/echo/v4"`)
 	} else if g.config.Framework == "fiber" {
 		imports = append(imports, `"github.com/gofiber/fiber/v2"`)
+	} else if g.config.Framework == "fasthttp" {
+		imports = append(imports, `"github.com/valyala/fasthttp"`)
+	}
+
+	if g.usesAccessLogLine() {
+		imports = append(imports, `"fmt"`, `"net"`)
+	}
+
+	if g.config.EnableWebSocket {
+		imports = append(imports, `"bufio"`)
+		if !g.usesAccessLogLine() {
+			imports = append(imports, `"net"`)
+		}
+	}
+
+	// Request IDs are generated by pkg/httpmw when the subset is exported
+	if g.config.ExportMiddleware {
+		imports = append(imports, fmt.Sprintf(`"%s/pkg/httpmw"`, g.config.ModulePath))
+	} else if g.config.DisableUUID {
+		imports = append(imports, `"crypto/rand"`, `"encoding/hex"`)
+	} else {
+		imports = append(imports, `"github.com/google/uuid"`)
+	}
+
+	if g.config.EnableCORS {
+		imports = append(imports, `"net/url"`)
+	}
+
+	if g.config.EnableMetrics {
+		imports = append(imports, `"strings"`, fmt.Sprintf(`"%s/internal/observability"`, g.config.ModulePath))
+		if g.config.Framework == "chi" {
+			imports = append(imports, `"github.com/go-chi/chi/v5"`)
+		}
 	}
 
 	if g.config.EnableTracing {
-		imports = append(imports,
-			`"go.opentelemetry.io/otel"`,
-			`"go.opentelemetry.io/otel/trace"`,
-		)
+		imports = append(imports, `"go.opentelemetry.io/otel/trace"`)
+	}
+
+	for _, imp := range g.getRealIPImports() {
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
 	}
 
 	standardMiddleware := g.getStandardMiddleware(loggerType)
-	frameworkMiddleware := g.getFrameworkMiddleware()
+	frameworkMiddleware := g.framework().MiddlewareSetup() + g.getFrameworkMetricsMiddleware() + g.getRealIPMiddleware() + g.getAccessLogLineFunc() + g.getCORSMiddleware(loggerType) + g.getMaxInflightMiddleware() + g.getRouteTimeoutMiddleware() + g.getTraceIDMiddleware()
 	tracingMiddleware := g.getTracingMiddlewareCode()
 
+	requestIDKey := `type contextKey string
+
+const RequestIDKey contextKey = "requestID"
+
+`
+	if g.config.ExportMiddleware {
+		requestIDKey = ""
+	}
+
 	return fmt.Sprintf(`package middleware
 
 import (
 	%s
-	"github.com/google/uuid"
 )
 
-type contextKey string
-
-const RequestIDKey contextKey = "requestID"
-
+%s%s
 %s
 %s
-%s
-`, strings.Join(imports, "\n\t"), standardMiddleware, frameworkMiddleware, tracingMiddleware)
+`, strings.Join(imports, "\n\t"), requestIDKey, standardMiddleware, frameworkMiddleware, tracingMiddleware)
 }
 
 func (g *Generator) getStandardMiddleware(loggerType string) string {
@@ -77,6 +123,7 @@ func (g *Generator) getStandardMiddleware(loggerType string) string {
 		slog.String("remote_addr", r.RemoteAddr),
 		slog.Duration("duration", duration),
 		slog.Int("status", rr.status),
+		slog.Int("bytes", rr.bytes),
 	)`
 	case "zap":
 		loggerImpl = `	logger.Info("HTTP request",
@@ -85,6 +132,7 @@ func (g *Generator) getStandardMiddleware(loggerType string) string {
 		zap.String("remote_addr", r.RemoteAddr),
 		zap.Duration("duration", duration),
 		zap.Int("status", rr.status),
+		zap.Int("bytes", rr.bytes),
 	)`
 	case "zerolog":
 		loggerImpl = `	logger.Info().
@@ -93,6 +141,7 @@ func (g *Generator) getStandardMiddleware(loggerType string) string {
 		Str("remote_addr", r.RemoteAddr).
 		Dur("duration", duration).
 		Int("status", rr.status).
+		Int("bytes", rr.bytes).
 		Msg("HTTP request")`
 	default:
 		loggerImpl = `	logger.Info("HTTP request",
@@ -101,14 +150,37 @@ func (g *Generator) getStandardMiddleware(loggerType string) string {
 		"remote_addr", r.RemoteAddr,
 		"duration", duration,
 		"status", rr.status,
+		"bytes", rr.bytes,
 	)`
 	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t", accessLogArgs{
+			remoteAddr: "r.RemoteAddr", method: "r.Method", uri: "r.RequestURI", proto: "r.Proto",
+			status: "rr.status", bytes: "rr.bytes", referer: "r.Referer()", userAgent: "r.UserAgent()",
+		})
+	}
+	if g.config.ExportMiddleware {
+		return g.getExportedStandardMiddleware(loggerType, loggerImpl)
+	}
+
+	return fmt.Sprintf(`// Middleware wraps an http.Handler with additional behavior.
+type Middleware func(http.Handler) http.Handler
+
+// Chain wraps h with middlewares so that the first one listed is outermost:
+// Chain(h, a, b) serves each request through a, then b, then h.
+func Chain(h http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
 
-	return fmt.Sprintf(`func RequestID(next http.Handler) http.Handler {
+%s
+func RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestID := r.Header.Get("X-Request-ID")
 		if requestID == "" {
-			requestID = uuid.New().String()
+			requestID = NewRequestID()
 		}
 		w.Header().Set("X-Request-ID", requestID)
 		ctx := context.WithValue(r.Context(), RequestIDKey, requestID)
@@ -116,53 +188,45 @@ func (g *Generator) getStandardMiddleware(loggerType string) string {
 	})
 }
 
-func Logger(next http.Handler, logger %s) http.Handler {
+// Logger logs every request and additionally warns about requests slower
+// than slowThreshold. A zero threshold disables the slow request warning.
+func Logger(next http.Handler, logger %s, slowThreshold time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		rr := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
 		next.ServeHTTP(rr, r)
 		duration := time.Since(start)
 		
+%s
 %s
 	})
 }
 
-func Recoverer(next http.Handler, logger %s) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer func() {
-			if err := recover(); err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-			}
-		}()
-		next.ServeHTTP(w, r)
-	})
-}
-
+%s
+%s
 type responseRecorder struct {
 	http.ResponseWriter
 	status int
+	bytes  int
 }
 
 func (r *responseRecorder) WriteHeader(status int) {
 	r.status = status
 	r.ResponseWriter.WriteHeader(status)
 }
-`, loggerType, loggerImpl, loggerType)
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
 }
 
-func (g *Generator) getFrameworkMiddleware() string {
-	switch g.config.Framework {
-	case "chi":
-		return g.getChiMiddleware()
-	case "gin":
-		return g.getGinMiddleware()
-	case "echo":
-		return g.getEchoMiddleware()
-	case "fiber":
-		return g.getFiberMiddleware()
-	default:
-		return ""
-	}
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// extend the write deadline.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+%s`, g.getNewRequestIDFunc(), loggerType, loggerImpl, g.getSlowRequestLog("\t", "r.Method", "r.URL.Path"), g.getRecovererFunc(loggerType), g.getMetricsMiddleware(), g.getResponseRecorderHijack())
 }
 
 func (g *Generator) getChiMiddleware() string {
@@ -197,6 +261,12 @@ func (g *Generator) getChiMiddleware() string {
 			"status", ww.Status(),
 		)`
 	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t\t", accessLogArgs{
+			remoteAddr: "r.RemoteAddr", method: "r.Method", uri: "r.RequestURI", proto: "r.Proto",
+			status: "ww.Status()", bytes: "ww.BytesWritten()", referer: "r.Referer()", userAgent: "r.UserAgent()",
+		})
+	}
 
 	loggerType := "*slog.Logger"
 	if g.config.Logger == "zap" {
@@ -206,7 +276,7 @@ func (g *Generator) getChiMiddleware() string {
 	}
 
 	return fmt.Sprintf(`
-func Logger(logger %s) func(next http.Handler) http.Handler {
+func ChiLogger(logger %s, slowThreshold time.Duration) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
@@ -214,13 +284,12 @@ func Logger(logger %s) func(next http.Handler) http.Handler {
 			next.ServeHTTP(ww, r)
 			duration := time.Since(start)
 			
+%s
 %s
 		})
 	}
 }
-
-import "github.com/go-chi/chi/v5/middleware"
-`, loggerType, loggerImpl)
+`, loggerType, loggerImpl, g.getSlowRequestLog("\t\t", "r.Method", "r.URL.Path"))
 }
 
 func (g *Generator) getGinMiddleware() string {
@@ -255,6 +324,12 @@ func (g *Generator) getGinMiddleware() string {
 			"status", c.Writer.Status(),
 		)`
 	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t\t", accessLogArgs{
+			remoteAddr: "c.Request.RemoteAddr", method: "c.Request.Method", uri: "c.Request.RequestURI", proto: "c.Request.Proto",
+			status: "c.Writer.Status()", bytes: "max(c.Writer.Size(), 0)", referer: "c.Request.Referer()", userAgent: "c.Request.UserAgent()",
+		})
+	}
 
 	loggerType := "*slog.Logger"
 	if g.config.Logger == "zap" {
@@ -264,16 +339,17 @@ func (g *Generator) getGinMiddleware() string {
 	}
 
 	return fmt.Sprintf(`
-func GinLogger(logger %s) gin.HandlerFunc {
+func GinLogger(logger %s, slowThreshold time.Duration) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		c.Next()
 		duration := time.Since(start)
 		
+%s
 %s
 	}
 }
-`, loggerType, loggerImpl)
+`, loggerType, loggerImpl, g.getSlowRequestLog("\t", "c.Request.Method", "c.Request.URL.Path"))
 }
 
 func (g *Generator) getEchoMiddleware() string {
@@ -308,6 +384,12 @@ func (g *Generator) getEchoMiddleware() string {
 			"status", c.Response().Status,
 		)`
 	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t\t", accessLogArgs{
+			remoteAddr: "c.Request().RemoteAddr", method: "c.Request().Method", uri: "c.Request().RequestURI", proto: "c.Request().Proto",
+			status: "c.Response().Status", bytes: "int(c.Response().Size)", referer: "c.Request().Referer()", userAgent: "c.Request().UserAgent()",
+		})
+	}
 
 	loggerType := "*slog.Logger"
 	if g.config.Logger == "zap" {
@@ -317,19 +399,20 @@ func (g *Generator) getEchoMiddleware() string {
 	}
 
 	return fmt.Sprintf(`
-func EchoLogger(logger %s) echo.MiddlewareFunc {
+func EchoLogger(logger %s, slowThreshold time.Duration) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
 			err := next(c)
 			duration := time.Since(start)
 			
+%s
 %s
 			return err
 		}
 	}
 }
-`, loggerType, loggerImpl)
+`, loggerType, loggerImpl, g.getSlowRequestLog("\t\t", "c.Request().Method", "c.Request().URL.Path"))
 }
 
 func (g *Generator) getFiberMiddleware() string {
@@ -337,33 +420,39 @@ func (g *Generator) getFiberMiddleware() string {
 	switch g.config.Logger {
 	case "slog":
 		loggerImpl = `		logger.Info("HTTP request",
-			slog.String("method", string(c.Request().Method())),
+			slog.String("method", c.Method()),
 			slog.String("path", c.Path()),
 			slog.Duration("duration", duration),
 			slog.Int("status", c.Response().StatusCode()),
 		)`
 	case "zap":
 		loggerImpl = `		logger.Info("HTTP request",
-			zap.String("method", string(c.Request().Method())),
+			zap.String("method", c.Method()),
 			zap.String("path", c.Path()),
 			zap.Duration("duration", duration),
 			zap.Int("status", c.Response().StatusCode()),
 		)`
 	case "zerolog":
 		loggerImpl = `		logger.Info().
-			Str("method", string(c.Request().Method())).
+			Str("method", c.Method()).
 			Str("path", c.Path()).
 			Dur("duration", duration).
 			Int("status", c.Response().StatusCode()).
 			Msg("HTTP request")`
 	default:
 		loggerImpl = `		logger.Info("HTTP request",
-			"method", string(c.Request().Method()),
+			"method", c.Method(),
 			"path", c.Path(),
 			"duration", duration,
 			"status", c.Response().StatusCode(),
 		)`
 	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t\t", accessLogArgs{
+			remoteAddr: "c.Context().RemoteAddr().String()", method: "c.Method()", uri: "c.OriginalURL()", proto: "c.Protocol()",
+			status: "c.Response().StatusCode()", bytes: "len(c.Response().Body())", referer: `c.Get("Referer")`, userAgent: `c.Get("User-Agent")`,
+		})
+	}
 
 	loggerType := "*slog.Logger"
 	if g.config.Logger == "zap" {
@@ -373,17 +462,404 @@ func (g *Generator) getFiberMiddleware() string {
 	}
 
 	return fmt.Sprintf(`
-func FiberLogger(logger %s) fiber.Handler {
+func FiberLogger(logger %s, slowThreshold time.Duration) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		start := time.Now()
 		err := c.Next()
 		duration := time.Since(start)
 		
+%s
 %s
 		return err
 	}
 }
-`, loggerType, loggerImpl)
+`, loggerType, loggerImpl, g.getSlowRequestLog("\t", "c.Method()", "c.Path()"))
+}
+
+func (g *Generator) getFastHTTPMiddleware() string {
+	loggerImpl := ""
+	switch g.config.Logger {
+	case "slog":
+		loggerImpl = `		logger.Info("HTTP request",
+			slog.String("method", string(ctx.Method())),
+			slog.String("path", string(ctx.Path())),
+			slog.Duration("duration", duration),
+			slog.Int("status", ctx.Response.StatusCode()),
+		)`
+	case "zap":
+		loggerImpl = `		logger.Info("HTTP request",
+			zap.String("method", string(ctx.Method())),
+			zap.String("path", string(ctx.Path())),
+			zap.Duration("duration", duration),
+			zap.Int("status", ctx.Response.StatusCode()),
+		)`
+	case "zerolog":
+		loggerImpl = `		logger.Info().
+			Str("method", string(ctx.Method())).
+			Str("path", string(ctx.Path())).
+			Dur("duration", duration).
+			Int("status", ctx.Response.StatusCode()).
+			Msg("HTTP request")`
+	default:
+		loggerImpl = `		logger.Info("HTTP request",
+			"method", string(ctx.Method()),
+			"path", string(ctx.Path()),
+			"duration", duration,
+			"status", ctx.Response.StatusCode(),
+		)`
+	}
+	if g.usesAccessLogLine() {
+		loggerImpl = g.getAccessLogStatement("\t\t", accessLogArgs{
+			remoteAddr: "ctx.RemoteAddr().String()", method: "string(ctx.Method())", uri: "string(ctx.RequestURI())", proto: "string(ctx.Request.Header.Protocol())",
+			status: "ctx.Response.StatusCode()", bytes: "len(ctx.Response.Body())", referer: "string(ctx.Referer())", userAgent: "string(ctx.UserAgent())",
+		})
+	}
+
+	loggerType := "*slog.Logger"
+	if g.config.Logger == "zap" {
+		loggerType = "*zap.Logger"
+	} else if g.config.Logger == "zerolog" {
+		loggerType = "*zerolog.Logger"
+	}
+
+	return fmt.Sprintf(`
+func FastHTTPRequestID(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		requestID := string(ctx.Request.Header.Peek("X-Request-ID"))
+		if requestID == "" {
+			requestID = NewRequestID()
+		}
+		ctx.Response.Header.Set("X-Request-ID", requestID)
+		ctx.SetUserValue(string(RequestIDKey), requestID)
+		next(ctx)
+	}
+}
+
+func FastHTTPLogger(next fasthttp.RequestHandler, logger %s, slowThreshold time.Duration) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		start := time.Now()
+		next(ctx)
+		duration := time.Since(start)
+		
+%s
+%s
+	}
+}
+%s`, loggerType, loggerImpl, g.getSlowRequestLog("\t", "string(ctx.Method())", "string(ctx.Path())"), g.getFastHTTPRecovererFunc())
+}
+
+// getNewRequestIDFunc returns the replaceable NewRequestID generator, backed
+// by crypto/rand when uuid is disabled and by github.com/google/uuid otherwise.
+func (g *Generator) getNewRequestIDFunc() string {
+	body := "\treturn uuid.New().String()"
+	if g.config.DisableUUID {
+		body = `	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)`
+	}
+
+	return fmt.Sprintf(`// NewRequestID generates the ID of requests arriving without an
+// X-Request-ID header. Assign it to plug in a custom generator.
+var NewRequestID = func() string {
+%s
+}
+`, body)
+}
+
+// getMetricsMiddleware returns the net/http middleware recording request
+// metrics, or "" when metrics are disabled.
+func (g *Generator) getMetricsMiddleware() string {
+	if !g.config.EnableMetrics {
+		return ""
+	}
+	return `
+// Metrics records the count, duration, and response size of every request.
+func Metrics(next http.Handler, obs *observability.Observability) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rr := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rr, r)
+		obs.RecordRequest(r.Method, metricsEndpoint(r.URL.Path), rr.status, rr.bytes, time.Since(start))
+	})
+}
+
+// metricsEndpoint returns the endpoint label of a path that matched no route
+// pattern. ID-like segments (numbers, UUIDs, long hex strings) are replaced
+// by :id so that /users/1 and /users/2 share one time series.
+func metricsEndpoint(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIDSegment(segment) {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isIDSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	digits, hex := true, len(segment) >= 16
+	for _, c := range segment {
+		digits = digits && c >= '0' && c <= '9'
+		hex = hex && (c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' || c == '-')
+	}
+	return digits || hex
+}
+`
+}
+
+// getFrameworkMetricsMiddleware returns the middleware recording request
+// metrics for the configured framework, or "" when metrics are disabled or
+// the framework uses the net/http Metrics. Requests are labelled by the
+// matched route pattern rather than the raw path to bound the cardinality of
+// the endpoint label.
+func (g *Generator) getFrameworkMetricsMiddleware() string {
+	if !g.config.EnableMetrics {
+		return ""
+	}
+
+	switch g.config.Framework {
+	case "chi":
+		return `
+// ChiMetrics records the count, duration, and response size of every request,
+// labelled by its route pattern (e.g. /users/{id}).
+func ChiMetrics(obs *observability.Observability) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			// The pattern is only complete once the router has matched
+			endpoint := unmatchedEndpoint
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				endpoint = rctx.RoutePattern()
+			}
+			obs.RecordRequest(r.Method, endpoint, ww.Status(), ww.BytesWritten(), time.Since(start))
+		})
+	}
+}
+
+// ChiPanicMetrics counts the panics on their way to middleware.Recoverer,
+// which it must come right after.
+func ChiPanicMetrics(obs *observability.Observability) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer countPanic(obs)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+` + unmatchedEndpointConst + countPanicFunc
+	case "gin":
+		return `
+// GinMetrics records the count, duration, and response size of every request,
+// labelled by its route pattern (e.g. /users/:id). It runs inside
+// gin.Recovery, so it also counts the panics on their way there.
+func GinMetrics(obs *observability.Observability) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		defer countPanic(obs)
+		c.Next()
+
+		endpoint := c.FullPath()
+		if endpoint == "" {
+			endpoint = unmatchedEndpoint
+		}
+		obs.RecordRequest(c.Request.Method, endpoint, c.Writer.Status(), max(c.Writer.Size(), 0), time.Since(start))
+	}
+}
+` + unmatchedEndpointConst + countPanicFunc
+	case "echo":
+		return `
+// EchoMetrics records the count, duration, and response size of every request,
+// labelled by its route pattern (e.g. /users/:id). It runs inside the Recover
+// middleware, so it also counts the panics on their way there.
+func EchoMetrics(obs *observability.Observability) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			defer countPanic(obs)
+			err := next(c)
+			if err != nil {
+				// Write the error response first so its status is recorded
+				c.Error(err)
+			}
+
+			endpoint := c.Path()
+			if endpoint == "" {
+				endpoint = unmatchedEndpoint
+			}
+			obs.RecordRequest(c.Request().Method, endpoint, c.Response().Status, int(c.Response().Size), time.Since(start))
+			return nil
+		}
+	}
+}
+` + unmatchedEndpointConst + countPanicFunc
+	case "fiber":
+		return `
+// FiberMetrics records the count, duration, and response size of every
+// request, labelled by its route pattern (e.g. /users/:id). It runs inside the
+// recover middleware, so it also counts the panics on their way there.
+func FiberMetrics(obs *observability.Observability) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		self := c.Route()
+		defer countPanic(obs)
+		err := c.Next()
+
+		// Without a matching route the context is left on this middleware
+		endpoint := c.Route().Path
+		if c.Route() == self {
+			endpoint = unmatchedEndpoint
+		}
+		obs.RecordRequest(c.Method(), endpoint, c.Response().StatusCode(), len(c.Response().Body()), time.Since(start))
+		return err
+	}
+}
+` + unmatchedEndpointConst + countPanicFunc
+	case "fasthttp":
+		return `
+// FastHTTPMetrics records the count, duration, and response size of every
+// request. fasthttp has no route patterns, so requests are labelled by their
+// path with ID-like segments replaced.
+func FastHTTPMetrics(next fasthttp.RequestHandler, obs *observability.Observability) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		start := time.Now()
+		next(ctx)
+		obs.RecordRequest(string(ctx.Method()), metricsEndpoint(string(ctx.Path())), ctx.Response.StatusCode(), len(ctx.Response.Body()), time.Since(start))
+	}
+}
+`
+	default:
+		return ""
+	}
+}
+
+// unmatchedEndpointConst declares the endpoint label shared by requests that
+// matched no route, so that scans of random paths add a single time series.
+const unmatchedEndpointConst = `
+// unmatchedEndpoint labels the metrics of requests that matched no route.
+const unmatchedEndpoint = "unmatched"
+`
+
+// countPanicFunc declares the deferred panic counter of the frameworks that
+// recover panics with their own middleware rather than a generated Recoverer.
+const countPanicFunc = `
+// countPanic counts a panic in http_panics_total and re-panics, leaving the
+// response to the recovery middleware. Call it deferred.
+func countPanic(obs *observability.Observability) {
+	if err := recover(); err != nil {
+		obs.RecordPanic()
+		panic(err)
+	}
+}
+`
+
+// accessLogArgs holds the framework-specific expressions passed to accessLogLine.
+type accessLogArgs struct {
+	remoteAddr, method, uri, proto string
+	status, bytes                  string
+	referer, userAgent             string
+}
+
+// usesAccessLogLine reports whether requests are logged as Common or Combined
+// Log Format lines instead of structured fields.
+func (g *Generator) usesAccessLogLine() bool {
+	return g.config.AccessLogFormat == "common" || g.config.AccessLogFormat == "combined"
+}
+
+// getAccessLogStatement returns the logger call emitting an access log line
+// as the raw message, indented by indent.
+func (g *Generator) getAccessLogStatement(indent string, args accessLogArgs) string {
+	call := fmt.Sprintf("accessLogLine(%s, %s, %s, %s, %s, %s, start",
+		args.remoteAddr, args.method, args.uri, args.proto, args.status, args.bytes)
+	if g.config.AccessLogFormat == "combined" {
+		call += fmt.Sprintf(", %s, %s", args.referer, args.userAgent)
+	}
+	call += ")"
+
+	if g.config.Logger == "zerolog" {
+		return fmt.Sprintf("%slogger.Info().Msg(%s)", indent, call)
+	}
+	return fmt.Sprintf("%slogger.Info(%s)", indent, call)
+}
+
+// getAccessLogLineFunc returns the accessLogLine helper formatting a request
+// in Common or Combined Log Format, or "" for structured logs.
+func (g *Generator) getAccessLogLineFunc() string {
+	switch g.config.AccessLogFormat {
+	case "common":
+		return `
+// accessLogLine formats a request in Common Log Format:
+// host - - [time] "METHOD uri proto" status bytes
+func accessLogLine(remoteAddr, method, uri, proto string, status, bytes int, start time.Time) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return fmt.Sprintf("%s - - [%s] \"%s %s %s\" %d %d",
+		host, start.Format("02/Jan/2006:15:04:05 -0700"), method, uri, proto, status, bytes)
+}
+`
+	case "combined":
+		return `
+// accessLogLine formats a request in Combined Log Format:
+// host - - [time] "METHOD uri proto" status bytes "referer" "user-agent"
+func accessLogLine(remoteAddr, method, uri, proto string, status, bytes int, start time.Time, referer, userAgent string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return fmt.Sprintf("%s - - [%s] \"%s %s %s\" %d %d \"%s\" \"%s\"",
+		host, start.Format("02/Jan/2006:15:04:05 -0700"), method, uri, proto, status, bytes, referer, userAgent)
+}
+`
+	default:
+		return ""
+	}
+}
+
+// getSlowRequestLog returns the statement that warns about requests slower
+// than slowThreshold, indented one level deeper than indent.
+func (g *Generator) getSlowRequestLog(indent, method, path string) string {
+	var fields string
+	switch g.config.Logger {
+	case "zap":
+		fields = fmt.Sprintf(`logger.Warn("Slow HTTP request",
+	zap.String("method", %s),
+	zap.String("path", %s),
+	zap.Duration("duration", duration),
+	zap.Duration("threshold", slowThreshold),
+)`, method, path)
+	case "zerolog":
+		fields = fmt.Sprintf(`logger.Warn().
+	Str("method", %s).
+	Str("path", %s).
+	Dur("duration", duration).
+	Dur("threshold", slowThreshold).
+	Msg("Slow HTTP request")`, method, path)
+	case "slog":
+		fields = fmt.Sprintf(`logger.Warn("Slow HTTP request",
+	slog.String("method", %s),
+	slog.String("path", %s),
+	slog.Duration("duration", duration),
+	slog.Duration("threshold", slowThreshold),
+)`, method, path)
+	default:
+		fields = fmt.Sprintf(`logger.Warn("Slow HTTP request",
+	"method", %s,
+	"path", %s,
+	"duration", duration,
+	"threshold", slowThreshold,
+)`, method, path)
+	}
+
+	block := "if slowThreshold > 0 && duration > slowThreshold {\n\t" +
+		strings.ReplaceAll(fields, "\n", "\n\t") + "\n}"
+	return indent + "\t" + strings.ReplaceAll(block, "\n", "\n\t"+indent)
 }
 
 func (g *Generator) getTracingMiddlewareCode() string {
@@ -433,11 +909,22 @@ func EchoTracing(tp trace.TracerProvider) echo.MiddlewareFunc {
 func FiberTracing(tp trace.TracerProvider) fiber.Handler {
 	tracer := tp.Tracer("http-server")
 	return func(c *fiber.Ctx) error {
-		ctx, span := tracer.Start(context.Background(), string(c.Request().Method())+" "+c.Path())
+		ctx, span := tracer.Start(context.Background(), c.Method()+" "+c.Path())
 		defer span.End()
 		c.Locals("trace_ctx", ctx)
 		return c.Next()
 	}
+}`
+	case "fasthttp":
+		return `
+func FastHTTPTracing(next fasthttp.RequestHandler, tp trace.TracerProvider) fasthttp.RequestHandler {
+	tracer := tp.Tracer("http-server")
+	return func(ctx *fasthttp.RequestCtx) {
+		spanCtx, span := tracer.Start(context.Background(), string(ctx.Method())+" "+string(ctx.Path()))
+		defer span.End()
+		ctx.SetUserValue("trace_ctx", spanCtx)
+		next(ctx)
+	}
 }`
 	default:
 		return `
@@ -0,0 +1,343 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerator_SlowRequestLogging(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		for _, logger := range []string{"slog", "zap", "zerolog"} {
+			t.Run(framework+"/"+logger, func(t *testing.T) {
+				cfg := createTestConfig()
+				cfg.Framework = framework
+				cfg.Logger = logger
+				gen, mfs := createTestGenerator(cfg)
+
+				if err := gen.Generate(); err != nil {
+					t.Fatalf("Generate failed: %v", err)
+				}
+
+				middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+				if !strings.Contains(middleware, "if slowThreshold > 0 && duration > slowThreshold {") {
+					t.Error("logger middleware should compare the duration against slowThreshold")
+				}
+				warn := `logger.Warn("Slow HTTP request",`
+				if logger == "zerolog" {
+					warn = `Msg("Slow HTTP request")`
+				}
+				if !strings.Contains(middleware, warn) {
+					t.Errorf("logger middleware should emit a warn log, want %s", warn)
+				}
+
+				server := mfs.FileContent("/output/test-project/internal/server/server.go")
+				if !strings.Contains(server, "obs.Logger, cfg.SlowRequestThreshold)") {
+					t.Error("server.go should pass the configured threshold to the logger middleware")
+				}
+			})
+		}
+	}
+}
+
+func TestGenerator_SlowRequestThresholdConfig(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.SlowRequestThreshold = 500 * time.Millisecond
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if !strings.Contains(config, `getEnvDuration("SLOW_REQUEST_THRESHOLD", 500 * time.Millisecond)`) {
+		t.Error("config.go should default SLOW_REQUEST_THRESHOLD to the configured threshold")
+	}
+
+	env := mfs.FileContent("/output/test-project/.env.example")
+	if !strings.Contains(env, "SLOW_REQUEST_THRESHOLD=500ms") {
+		t.Error(".env.example should document SLOW_REQUEST_THRESHOLD")
+	}
+}
+
+func TestGenerator_SlowRequestThresholdStructuredConfig(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "yaml"
+	cfg.SlowRequestThreshold = time.Second
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	config := mfs.FileContent("/output/test-project/internal/config/config.go")
+	if !strings.Contains(config, "func (c *Config) GetSlowRequestThreshold() time.Duration") {
+		t.Error("config.go should expose GetSlowRequestThreshold")
+	}
+
+	example := mfs.FileContent("/output/test-project/config.yaml.example")
+	if !strings.Contains(example, "slow_request_threshold: 1s") {
+		t.Error("config.yaml.example should set slow_request_threshold")
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, "cfg.GetSlowRequestThreshold()") {
+		t.Error("server.go should read the threshold through the accessor")
+	}
+}
+
+func TestGenerator_AccessLogFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		call   string
+		line   string
+	}{
+		{
+			format: "common",
+			call:   "logger.Info(accessLogLine(r.RemoteAddr, r.Method, r.RequestURI, r.Proto, rr.status, rr.bytes, start))",
+			line:   `"%s - - [%s] \"%s %s %s\" %d %d",`,
+		},
+		{
+			format: "combined",
+			call:   "logger.Info(accessLogLine(r.RemoteAddr, r.Method, r.RequestURI, r.Proto, rr.status, rr.bytes, start, r.Referer(), r.UserAgent()))",
+			line:   `"%s - - [%s] \"%s %s %s\" %d %d \"%s\" \"%s\"",`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = "stdlib"
+			cfg.AccessLogFormat = tt.format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			content := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			for _, check := range []string{tt.call, tt.line, "r.bytes += n", `"net"`} {
+				if !strings.Contains(content, check) {
+					t.Errorf("middleware.go should contain %q", check)
+				}
+			}
+			if strings.Contains(content, `logger.Info("HTTP request",`) {
+				t.Error("access log formats should replace the structured request log")
+			}
+		})
+	}
+}
+
+func TestGenerator_AccessLogFormat_Structured(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "stdlib"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if strings.Contains(content, "accessLogLine") {
+		t.Error("structured logs should not generate accessLogLine")
+	}
+	if !strings.Contains(content, `logger.Info("HTTP request",`) {
+		t.Error("structured logs should keep the HTTP request log")
+	}
+}
+
+func TestGenerator_StdlibMiddlewareOrder(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "stdlib"
+	cfg.EnableTracing = true
+	cfg.EnableMetrics = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	order := []string{
+		"middleware.Chain(mux,",
+		"return middleware.Recoverer(next, obs.Logger, obs)",
+		"middleware.RequestID,",
+		"return middleware.Tracing(next, obs.TracerProvider)",
+		"return middleware.Logger(next, obs.Logger,",
+		"return middleware.Metrics(next, obs)",
+	}
+	last := -1
+	for _, check := range order {
+		idx := strings.Index(server, check)
+		if idx < 0 {
+			t.Fatalf("server.go should contain %q", check)
+		}
+		if idx < last {
+			t.Errorf("%q is out of order; Recoverer should be outermost, then RequestID", check)
+		}
+		last = idx
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if !strings.Contains(middleware, "func Chain(h http.Handler, middlewares ...Middleware) http.Handler {") {
+		t.Error("middleware.go should define Chain")
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	if !strings.Contains(tests, "func TestChain_RecoversPanicWithRequestID(t *testing.T) {") {
+		t.Error("middleware_test.go should test panic recovery with a request ID")
+	}
+}
+
+func TestGenerator_MiddlewareTestsOnlyForStdlib(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "gin"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/middleware/middleware_test.go") {
+		t.Error("middleware_test.go exercises the net/http Chain and is stdlib-only")
+	}
+}
+
+func TestGenerator_DisableUUID(t *testing.T) {
+	for _, framework := range []string{"stdlib", "fasthttp"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.DisableUUID = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			goMod := mfs.FileContent("/output/test-project/go.mod")
+			if strings.Contains(goMod, "github.com/google/uuid") {
+				t.Error("go.mod should not require uuid with the crypto/rand generator")
+			}
+
+			middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			if strings.Contains(middleware, "uuid") {
+				t.Error("middleware.go should not use uuid with the crypto/rand generator")
+			}
+			for _, check := range []string{`"crypto/rand"`, "var NewRequestID = func() string {", "requestID = NewRequestID()"} {
+				if !strings.Contains(middleware, check) {
+					t.Errorf("middleware.go should contain %q", check)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_UUIDRequestIDByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "github.com/google/uuid") {
+		t.Error("go.mod should require uuid by default")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/middleware/middleware.go"), "return uuid.New().String()") {
+		t.Error("NewRequestID should use uuid by default")
+	}
+}
+
+func TestGenerator_ExportMiddleware(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "stdlib"
+	cfg.Logger = "zap"
+	cfg.ExportMiddleware = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	httpmw := mfs.FileContent("/output/test-project/pkg/httpmw/httpmw.go")
+	for _, check := range []string{
+		"package httpmw",
+		"func RequestID(next http.Handler) http.Handler {",
+		"type LogFunc func(r *http.Request, status, bytes int, duration time.Duration)",
+		"func Logger(next http.Handler, log LogFunc) http.Handler {",
+		"func Recoverer(next http.Handler) http.Handler {",
+	} {
+		if !strings.Contains(httpmw, check) {
+			t.Errorf("pkg/httpmw/httpmw.go should contain %q", check)
+		}
+	}
+	if strings.Contains(httpmw, "zap") {
+		t.Error("pkg/httpmw should not depend on the configured logger")
+	}
+	if !mfs.HasFile("/output/test-project/pkg/httpmw/httpmw_test.go") {
+		t.Error("pkg/httpmw should have tests")
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if !strings.Contains(middleware, `"github.com/test/test-project/pkg/httpmw"`) {
+		t.Error("internal middleware should import pkg/httpmw")
+	}
+	if !strings.Contains(middleware, "return httpmw.Logger(next, func(r *http.Request, status, bytes int, duration time.Duration) {") {
+		t.Error("internal Logger should adapt the zap logger to httpmw.Logger")
+	}
+	if strings.Contains(middleware, "func RequestID(") || strings.Contains(middleware, "func Recoverer(") {
+		t.Error("RequestID and Recoverer should only be defined in pkg/httpmw")
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	for _, check := range []string{
+		`"github.com/test/test-project/pkg/httpmw"`,
+		"h := httpmw.Chain(mux,\n\t\thttpmw.Recoverer,\n\t\thttpmw.RequestID,",
+		"return middleware.Logger(next, obs.Logger,",
+	} {
+		if !strings.Contains(server, check) {
+			t.Errorf("server.go should contain %q", check)
+		}
+	}
+	if mfs.HasFile("/output/test-project/internal/middleware/middleware_test.go") {
+		t.Error("Chain tests should move to pkg/httpmw")
+	}
+}
+
+func TestGenerator_ExportMiddlewareCountsPanics(t *testing.T) {
+	for _, disableRecover := range []bool{false, true} {
+		cfg := createTestConfig()
+		cfg.Framework = "stdlib"
+		cfg.ExportMiddleware = true
+		cfg.EnableMetrics = true
+		cfg.DisableRecover = disableRecover
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		httpmw := mfs.FileContent("/output/test-project/pkg/httpmw/httpmw.go")
+		if !strings.Contains(httpmw, "func RecovererWith(onPanic func()) Middleware {") {
+			t.Error("pkg/httpmw should offer a Recoverer with a panic hook")
+		}
+
+		server := mfs.FileContent("/output/test-project/internal/server/server.go")
+		if !strings.Contains(server, "httpmw.RecovererWith(obs.RecordPanic)") {
+			t.Errorf("server.go should count recovered panics (disableRecover=%v)", disableRecover)
+		}
+
+		tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+		for _, check := range []string{
+			`"github.com/test/test-project/pkg/httpmw"`,
+			"func TestRecovererWith_CountsPanics(t *testing.T) {",
+			"h := httpmw.RecovererWith(obs.RecordPanic)(",
+			"assert.Equal(t, before+1, panicsTotal(t, obs))",
+		} {
+			if !strings.Contains(tests, check) {
+				t.Errorf("middleware_test.go should contain %q (disableRecover=%v)", check, disableRecover)
+			}
+		}
+	}
+}
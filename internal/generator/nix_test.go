@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Nix(t *testing.T) {
+	tests := []struct {
+		goVersion string
+		channel   string
+		goPackage string
+	}{
+		{"1.21", "nixos-24.05", "pkgs.go_1_21"},
+		{"1.22", "nixos-24.11", "pkgs.go_1_22"},
+		{"1.23", "nixos-25.05", "pkgs.go_1_23"},
+		{"1.24", "nixos-25.05", "pkgs.go_1_24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goVersion, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.GoVersion = tt.goVersion
+			cfg.Nix = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			flake := mfs.FileContent("/output/test-project/flake.nix")
+			for _, want := range []string{
+				`inputs.nixpkgs.url = "github:NixOS/nixpkgs/` + tt.channel + `";`,
+				tt.goPackage + "\n",
+				"pkgs.golangci-lint\n",
+				"pkgs.mockgen\n",
+			} {
+				if !strings.Contains(flake, want) {
+					t.Errorf("flake.nix should contain %q", want)
+				}
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/README.md"), "`nix develop` opens a shell with Go "+tt.goVersion) {
+				t.Error("README should document the Nix dev shell")
+			}
+		})
+	}
+}
+
+func TestGenerator_NixDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/flake.nix") {
+		t.Error("flake.nix should not be generated unless --nix is set")
+	}
+}
@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"go/scanner"
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// commentMark stands in for a stripped comment until the lines left empty by
+// it are dropped.
+const commentMark = "\x00"
+
+// generatedCodeComment matches the comment marking a file as generated code,
+// which tools rely on like a directive.
+var generatedCodeComment = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// commentedEnvAssignment matches an optional variable left commented out in
+// .env.example, e.g. "# METRICS_PATH=/metrics".
+var commentedEnvAssignment = regexp.MustCompile(`^#\s*[A-Z][A-Z0-9_]*=`)
+
+// stripComments returns content without its explanatory comments under
+// --no-comments: every comment of a Go file but its directives, the comments
+// of .env.example but the optional variables, and the header of the config
+// file examples. Other files are returned unchanged.
+func (g *Generator) stripComments(relativePath, content string) string {
+	if !g.config.NoComments {
+		return content
+	}
+
+	name := path.Base(relativePath)
+	switch {
+	case strings.HasSuffix(name, ".go"):
+		return stripGoComments(content)
+	case name == ".env.example":
+		return stripEnvComments(content)
+	case strings.HasPrefix(name, "config.") && strings.HasSuffix(name, ".example"):
+		return stripConfigHeader(content)
+	default:
+		return content
+	}
+}
+
+// stripGoComments removes the comments of src, keeping the directives. The
+// go/scanner tokens tell comments from "//" inside strings and struct tags,
+// and don't require src to parse.
+func stripGoComments(src string) string {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, []byte(src), nil, scanner.ScanComments)
+
+	var sb strings.Builder
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok != token.COMMENT || isDirectiveComment(lit) {
+			continue
+		}
+		start := file.Offset(pos)
+		sb.WriteString(src[last:start])
+		sb.WriteString(commentMark)
+		last = start + len(lit)
+	}
+	sb.WriteString(src[last:])
+
+	return dropEmptiedLines(sb.String())
+}
+
+// isDirectiveComment reports whether the comment lit is read by the go tool
+// or other tools rather than by people.
+func isDirectiveComment(lit string) bool {
+	for _, prefix := range []string{"//go:", "//line ", "//export ", "//extern ", "//nolint", "// +build"} {
+		if strings.HasPrefix(lit, prefix) {
+			return true
+		}
+	}
+	return generatedCodeComment.MatchString(lit)
+}
+
+// dropEmptiedLines removes the comment marks of src with the whitespace
+// before them, drops the lines they leave empty, and collapses the blank
+// lines that were separated only by such lines.
+func dropEmptiedLines(src string) string {
+	var kept []string
+	dropped := false
+	for _, line := range strings.Split(src, "\n") {
+		if strings.Contains(line, commentMark) {
+			line = strings.TrimRight(strings.ReplaceAll(line, commentMark, ""), " \t")
+			if strings.TrimSpace(line) == "" {
+				dropped = true
+				continue
+			}
+		}
+		if line == "" && dropped && (len(kept) == 0 || kept[len(kept)-1] == "") {
+			continue
+		}
+		dropped = false
+		kept = append(kept, line)
+	}
+	return strings.Join(kept, "\n")
+}
+
+// stripEnvComments removes the comments and blank lines of a .env file,
+// keeping the optional variables left commented out and one blank line in
+// place of each "# ====" section banner.
+func stripEnvComments(src string) string {
+	var kept []string
+	section := false
+	for _, line := range strings.Split(strings.TrimRight(src, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "# ="):
+			section = true
+		case strings.TrimSpace(line) == "",
+			strings.HasPrefix(line, "#") && !commentedEnvAssignment.MatchString(line):
+			// Blank lines and explanations are dropped
+		default:
+			if section && len(kept) > 0 {
+				kept = append(kept, "")
+			}
+			section = false
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n") + "\n"
+}
+
+// stripConfigHeader removes the leading comment block of a config file
+// example, up to and including the blank line after it.
+func stripConfigHeader(src string) string {
+	if !strings.HasPrefix(src, "#") {
+		return src
+	}
+	if _, rest, found := strings.Cut(src, "\n\n"); found {
+		return rest
+	}
+	return src
+}
@@ -0,0 +1,133 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_NoComments(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	cfg.BuildTags = true
+	cfg.EnvSample = true
+	cfg.NoComments = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	mocks := mfs.FileContent("/output/test-project/internal/mocks/interfaces.go")
+	if !strings.Contains(mocks, "//go:generate mockgen -source=interfaces.go -destination=mocks.go -package=mocks") {
+		t.Error("//go:generate should survive --no-comments")
+	}
+	pprof := mfs.FileContent("/output/test-project/cmd/test-project/pprof_debug.go")
+	if !strings.HasPrefix(pprof, "//go:build debug\n\npackage main") {
+		t.Error("//go:build should survive --no-comments, still separated from the package clause")
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if strings.Contains(middleware, "// Middleware wraps an http.Handler") {
+		t.Error("explanatory comments should be removed from middleware.go")
+	}
+	if !strings.Contains(middleware, "type Middleware func(http.Handler) http.Handler") {
+		t.Error("the code after a removed comment should be kept")
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	if !strings.Contains(handlers, "`json:\"status\"`") {
+		t.Error("struct tags should survive --no-comments")
+	}
+
+	env := mfs.FileContent("/output/test-project/.env.example")
+	if strings.Contains(env, "# ====") || strings.Contains(env, "# Copy this file to .env") {
+		t.Error(".env.example should drop its header and section banners")
+	}
+	if !strings.Contains(env, "ENVIRONMENT=development\n") || !strings.Contains(env, "# POSTGRES_MAX_CONNECTIONS=25") {
+		t.Error(".env.example should keep its variables, including the commented-out optional ones")
+	}
+}
+
+func TestGenerator_NoCommentsConfigHeader(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "yaml"
+	cfg.NoComments = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	example := mfs.FileContent("/output/test-project/config.yaml.example")
+	if strings.Contains(example, "test-project Configuration") {
+		t.Error("config.yaml.example should drop its header")
+	}
+	if !strings.HasPrefix(example, "# Application settings\napp:\n") {
+		t.Errorf("config.yaml.example should start with the app settings, got %q", example[:min(len(example), 40)])
+	}
+}
+
+func TestGenerator_CommentsKeptByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/middleware/middleware.go"), "// Middleware wraps an http.Handler") {
+		t.Error("comments should be kept without --no-comments")
+	}
+}
+
+func TestStripGoComments(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "doc comment",
+			src:  "package p\n\n// F does nothing.\nfunc F() {}\n",
+			want: "package p\n\nfunc F() {}\n",
+		},
+		{
+			name: "trailing comment",
+			src:  "package p\n\nvar x = 1 // one\n",
+			want: "package p\n\nvar x = 1\n",
+		},
+		{
+			name: "slashes in strings",
+			src:  "package p\n\nvar u = \"http://localhost\" + `//raw`\n",
+			want: "package p\n\nvar u = \"http://localhost\" + `//raw`\n",
+		},
+		{
+			name: "struct tag",
+			src:  "package p\n\ntype T struct {\n\tURL string `json:\"url\"` // the URL\n}\n",
+			want: "package p\n\ntype T struct {\n\tURL string `json:\"url\"`\n}\n",
+		},
+		{
+			name: "directives",
+			src:  "//go:build tools\n\n// Package p pins tools.\npackage p\n\n//go:generate stringer -type=T\n//nolint:unused\nvar x int\n",
+			want: "//go:build tools\n\npackage p\n\n//go:generate stringer -type=T\n//nolint:unused\nvar x int\n",
+		},
+		{
+			name: "comment between blank lines",
+			src:  "package p\n\n// section\n\nvar x int\n",
+			want: "package p\n\nvar x int\n",
+		},
+		{
+			name: "block comment",
+			src:  "package p\n\n/*\nLong\nexplanation.\n*/\nvar x int\n",
+			want: "package p\n\nvar x int\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripGoComments(tt.src); got != tt.want {
+				t.Errorf("stripGoComments() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,16 +21,25 @@ func (g *Generator) getObservabilityContent() string {
 
 	switch g.config.Logger {
 	case "slog":
-		imports = append(imports, `"log/slog"`, `"os"`)
+		imports = append(imports, `"log/slog"`)
 		loggerField = "Logger *slog.Logger"
 	case "zap":
 		imports = append(imports, `"go.uber.org/zap"`)
 		loggerField = "Logger *zap.Logger"
 	case "zerolog":
-		imports = append(imports, `"github.com/rs/zerolog"`, `"os"`)
+		imports = append(imports, `"github.com/rs/zerolog"`)
 		loggerField = "Logger *zerolog.Logger"
 	}
 
+	loggerShutdown := ""
+	if g.config.OtelLogs {
+		loggerField += "\n\tloggerShutdown func(context.Context) error"
+		loggerShutdown = `
+	if o.loggerShutdown != nil {
+		_ = o.loggerShutdown(ctx)
+	}`
+	}
+
 	tracerField := ""
 	tracerInit := ""
 	tracerShutdown := ""
@@ -42,17 +51,25 @@ func (g *Generator) getObservabilityContent() string {
 			`"go.opentelemetry.io/otel/sdk/trace"`,
 			`semconv "go.opentelemetry.io/otel/semconv/v1.21.0"`,
 		)
-		tracerField = `	TracerProvider trace.TracerProvider
+		if g.config.OTLPSecure {
+			imports = append(imports, `"google.golang.org/grpc/credentials"`)
+		}
+		tracerField = `	TracerProvider *trace.TracerProvider
 	tracerShutdown func(context.Context) error`
 
-		tracerInit = `
+		tracerInit = fmt.Sprintf(`
 	tp, shutdown, err := initTracer(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 	obs.TracerProvider = tp
 	obs.tracerShutdown = shutdown
-	otel.SetTracerProvider(tp)`
+	otel.SetTracerProvider(tp)
+
+	// Log export failures, which the batch span processor otherwise drops silently
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		%s
+	}))`, g.getOTelErrorLog())
 
 		tracerShutdown = `
 	if o.tracerShutdown != nil {
@@ -68,31 +85,82 @@ func (g *Generator) getObservabilityContent() string {
 			`"github.com/prometheus/client_golang/prometheus"`,
 			`"github.com/prometheus/client_golang/prometheus/promhttp"`,
 			`"github.com/prometheus/client_golang/prometheus/promauto"`,
+			`"strconv"`,
+			`"time"`,
 		)
 		metricsField = `	httpRequestsTotal    *prometheus.CounterVec
-	httpRequestDuration  *prometheus.HistogramVec`
+` + g.getRequestDurationField() + `
+	httpResponseSize     *prometheus.HistogramVec
+	httpPanicsTotal      prometheus.Counter
+	httpErrorsTotal      *prometheus.CounterVec`
 
-		metricsInit = `
+		metricsInit = fmt.Sprintf(`
 	obs.httpRequestsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
-			Name: "http_requests_total",
-			Help: "Total number of HTTP requests",
+%s
 		},
 		[]string{"method", "endpoint", "status"},
 	)
 	
-	obs.httpRequestDuration = promauto.NewHistogramVec(
+%s
+	
+	obs.httpResponseSize = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
-			Name:    "http_request_duration_seconds",
-			Help:    "HTTP request duration in seconds",
-			Buckets: prometheus.DefBuckets,
+%s
 		},
 		[]string{"method", "endpoint"},
-	)`
+	)
+
+	obs.httpPanicsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+%s
+		},
+	)
+
+	obs.httpErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+%s
+		},
+		[]string{"status"},
+	)`,
+			g.getMetricOpts(
+				[2]string{"Name", `"http_requests_total"`},
+				[2]string{"Help", `"Total number of HTTP requests"`},
+			),
+			g.getRequestDurationInit(),
+			g.getMetricOpts(
+				[2]string{"Name", `"http_response_size_bytes"`},
+				[2]string{"Help", `"HTTP response size in bytes"`},
+				[2]string{"Buckets", "prometheus.ExponentialBuckets(100, 10, 7)"},
+			),
+			g.getMetricOpts(
+				[2]string{"Name", `"http_panics_total"`},
+				[2]string{"Help", `"Total number of panics recovered while serving HTTP requests"`},
+			),
+			g.getMetricOpts(
+				[2]string{"Name", `"http_errors_total"`},
+				[2]string{"Help", `"Total number of HTTP responses with a 5xx status"`},
+			))
 
 		metricsHandler = `
 func (o *Observability) MetricsHandler() http.Handler {
 	return promhttp.Handler()
+}
+
+// RecordRequest records the count, duration, and response size of a request,
+// and counts it in http_errors_total when its status is 5xx.
+func (o *Observability) RecordRequest(method, endpoint string, status, bytes int, duration time.Duration) {
+	o.httpRequestsTotal.WithLabelValues(method, endpoint, strconv.Itoa(status)).Inc()
+	o.httpRequestDuration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+	o.httpResponseSize.WithLabelValues(method, endpoint).Observe(float64(bytes))
+	if status >= http.StatusInternalServerError {
+		o.httpErrorsTotal.WithLabelValues(strconv.Itoa(status)).Inc()
+	}
+}
+
+// RecordPanic counts a panic raised while serving a request.
+func (o *Observability) RecordPanic() {
+	o.httpPanicsTotal.Inc()
 }`
 	}
 
@@ -125,15 +193,38 @@ func New(ctx context.Context, cfg *config.Config) (*Observability, error) {
 }
 
 func (o *Observability) Shutdown(ctx context.Context) error {
-%s
+%s%s
 	return nil
 }
 %s
 %s
-`, strings.Join(imports, "\n\t"), loggerField, tracerField, metricsField, loggerInit, tracerInit, metricsInit, tracerShutdown, metricsHandler, tracerImplementation)
+`, strings.Join(imports, "\n\t"), loggerField, tracerField, metricsField, loggerInit, tracerInit, metricsInit, loggerShutdown, tracerShutdown, metricsHandler, tracerImplementation)
+}
+
+// getOTelErrorLog returns the statement logging an OpenTelemetry error err
+// with the configured logger.
+func (g *Generator) getOTelErrorLog() string {
+	switch g.config.Logger {
+	case "zap":
+		return `obs.Logger.Error("OpenTelemetry error", zap.Error(err))`
+	case "zerolog":
+		return `obs.Logger.Error().Err(err).Msg("OpenTelemetry error")`
+	default:
+		return `obs.Logger.Error("OpenTelemetry error", "error", err)`
+	}
 }
 
 func (g *Generator) getLoggerInitialization() string {
+	if g.config.OtelLogs {
+		return `	logger, loggerShutdown, err := NewOTelLogger(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	obs.Logger = logger
+	obs.loggerShutdown = loggerShutdown
+	SetDefaultLogger(logger)`
+	}
+
 	switch g.config.Logger {
 	case "slog":
 		return `	obs.Logger = NewLogger(cfg)`
@@ -151,15 +242,12 @@ func (g *Generator) getLoggerInitialization() string {
 }
 
 func (g *Generator) getTracerImplementation() string {
-	otlpRef := g.getConfigFieldReference("OTLPEndpoint")
 	serviceNameRef := g.getConfigFieldReference("ServiceName")
 
 	return fmt.Sprintf(`
-func initTracer(ctx context.Context, cfg *config.Config) (trace.TracerProvider, func(context.Context) error, error) {
+func initTracer(ctx context.Context, cfg *config.Config) (*trace.TracerProvider, func(context.Context) error, error) {
 	exporter, err := otlptracegrpc.New(ctx,
-		otlptracegrpc.WithEndpoint(%s),
-		otlptracegrpc.WithInsecure(),
-	)
+%s	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,7 +267,7 @@ func initTracer(ctx context.Context, cfg *config.Config) (trace.TracerProvider,
 	)
 
 	return tp, tp.Shutdown, nil
-}`, otlpRef, serviceNameRef)
+}`, g.getOTLPExporterOptions("otlptracegrpc"), serviceNameRef)
 }
 
 func (g *Generator) generateLoggerFile() error {
@@ -195,10 +283,7 @@ func (g *Generator) getLoggerFileContent() string {
 		return fmt.Sprintf(`package observability
 
 import (
-	"log/slog"
-	"os"
-
-	"%s/internal/config"
+%s
 )
 
 var defaultLogger *slog.Logger
@@ -211,18 +296,17 @@ func NewLogger(cfg *config.Config) *slog.Logger {
 		level = slog.LevelDebug
 	}
 
-	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: level,
-	})
+%s	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+%s	})
 
-	return slog.New(handler)
+	return slog.New(%s)
 }
 
 func SetDefaultLogger(logger *slog.Logger) {
 	defaultLogger = logger
 	slog.SetDefault(logger)
 }
-`, g.config.ModulePath, envRef)
+%s%s%s%s`, g.getSlogLoggerImports(), envRef, g.getSlogLevelVarSet(), g.getSlogHandlerOptions(), g.getSlogSamplingHandler(), g.getCycleLogLevelFunc(), g.getSlogStackdriverFuncs(), g.getSlogSamplingFuncs(), g.getOTelLoggerFunc())
 
 	case "zap":
 		return fmt.Sprintf(`package observability
@@ -241,14 +325,14 @@ func NewZapLogger(cfg *config.Config) (*zap.Logger, error) {
 		zapConfig = zap.NewProductionConfig()
 	} else {
 		zapConfig = zap.NewDevelopmentConfig()
-		zapConfig.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-	}
-
+%s	}
+%s%s%s
 	return zapConfig.Build()
 }
-`, g.config.ModulePath, envRef)
+%s%s`, g.config.ModulePath, envRef, g.getZapDevelopmentEncoding(), g.getZapStackdriverConfig(), g.getZapAtomicLevelSet(), g.getZapSamplingConfig(), g.getCycleLogLevelFunc(), g.getZapStackdriverFuncs())
 
 	case "zerolog":
+		zerologGlobalLevel, zerologOwnLevel := g.getZerologLevel()
 		return fmt.Sprintf(`package observability
 
 import (
@@ -269,17 +353,101 @@ func NewZerologLogger(cfg *config.Config) *zerolog.Logger {
 		level = zerolog.DebugLevel
 	}
 
-	logger := zerolog.New(os.Stdout).
-		Level(level).
-		With().
+%s	logger := zerolog.New(os.Stdout).
+%s		With().
 		Timestamp().
 		Logger()
-
+%s
 	return &logger
 }
-`, g.config.ModulePath, envRef)
+%s`, g.config.ModulePath, envRef, zerologGlobalLevel, zerologOwnLevel, g.getZerologSampling(), g.getCycleLogLevelFunc())
 
 	default:
 		return ""
 	}
 }
+
+// getMetricOpts returns the aligned fields of a Prometheus metric opts literal,
+// preceded by the configured Namespace and Subsystem, if any.
+func (g *Generator) getMetricOpts(fields ...[2]string) string {
+	var opts [][2]string
+	if g.config.MetricsNamespace != "" {
+		opts = append(opts, [2]string{"Namespace", fmt.Sprintf("%q", g.config.MetricsNamespace)})
+	}
+	if g.config.MetricsSubsystem != "" {
+		opts = append(opts, [2]string{"Subsystem", fmt.Sprintf("%q", g.config.MetricsSubsystem)})
+	}
+	opts = append(opts, fields...)
+
+	width := 0
+	for _, opt := range opts {
+		width = max(width, len(opt[0]))
+	}
+
+	lines := make([]string, 0, len(opts))
+	for _, opt := range opts {
+		lines = append(lines, fmt.Sprintf("\t\t\t%-*s %s,", width+1, opt[0]+":", opt[1]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getSlogLoggerImports returns the import block of the slog logger.go,
+// including the OTLP logs exporter when the otelslog bridge is enabled.
+func (g *Generator) getSlogLoggerImports() string {
+	imports := []string{`"log/slog"`, `"os"`}
+	if g.config.LogSampling {
+		imports = append(imports, `"sync"`, `"time"`)
+	}
+	if g.config.OtelLogs || g.config.LogSampling {
+		imports = append([]string{`"context"`}, imports...)
+	}
+	if g.config.OtelLogs {
+		imports = append(imports,
+			"",
+			`"go.opentelemetry.io/contrib/bridges/otelslog"`,
+			`"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"`,
+			`"go.opentelemetry.io/otel/log/global"`,
+			`sdklog "go.opentelemetry.io/otel/sdk/log"`,
+		)
+		if g.config.OTLPSecure {
+			imports = append(imports, `"google.golang.org/grpc/credentials"`)
+		}
+	}
+	imports = append(imports, "", fmt.Sprintf(`"%s/internal/config"`, g.config.ModulePath))
+
+	lines := make([]string, len(imports))
+	for i, imp := range imports {
+		if imp != "" {
+			lines[i] = "\t" + imp
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getOTelLoggerFunc returns NewOTelLogger, which bridges slog records to the
+// OTLP logs exporter so logs reach the same collector as traces.
+func (g *Generator) getOTelLoggerFunc() string {
+	if !g.config.OtelLogs {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+// NewOTelLogger returns a logger exporting records over OTLP through the
+// otelslog bridge, and a function flushing pending records on shutdown.
+func NewOTelLogger(ctx context.Context, cfg *config.Config) (*slog.Logger, func(context.Context) error, error) {
+	exporter, err := otlploggrpc.New(ctx,
+%s	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+	)
+	global.SetLoggerProvider(provider)
+
+	logger := slog.New(otelslog.NewHandler(%s, otelslog.WithLoggerProvider(provider)))
+	return logger, provider.Shutdown, nil
+}
+`, g.getOTLPExporterOptions("otlploggrpc"), g.getConfigFieldReference("ServiceName"))
+}
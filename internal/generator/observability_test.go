@@ -0,0 +1,294 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_MetricsNamespace(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableMetrics = true
+	cfg.MetricsNamespace = "myapp"
+	cfg.MetricsSubsystem = "api"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+
+	if got := strings.Count(content, `Namespace: "myapp",`); got != 5 {
+		t.Errorf("every metric opts should set Namespace, found %d", got)
+	}
+	if got := strings.Count(content, `Subsystem: "api",`); got != 5 {
+		t.Errorf("every metric opts should set Subsystem, found %d", got)
+	}
+	if !strings.Contains(content, `Name:      "http_requests_total",`) {
+		t.Error("metric names should stay unprefixed and aligned with Namespace")
+	}
+}
+
+func TestGenerator_MetricsNamespace_Default(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableMetrics = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+
+	if strings.Contains(content, "Namespace:") || strings.Contains(content, "Subsystem:") {
+		t.Error("metric opts should not set Namespace or Subsystem by default")
+	}
+	if !strings.Contains(content, `Name: "http_requests_total",`) {
+		t.Error("metric opts should keep the default metric name")
+	}
+}
+
+func TestGenerator_ResponseSizeMetrics(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "stdlib"
+	cfg.EnableMetrics = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	obs := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+	for _, check := range []string{
+		`Name:    "http_response_size_bytes",`,
+		"o.httpResponseSize.WithLabelValues(method, endpoint).Observe(float64(bytes))",
+	} {
+		if !strings.Contains(obs, check) {
+			t.Errorf("observability.go should contain %q", check)
+		}
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	for _, check := range []string{
+		"r.bytes += n",
+		`slog.Int("bytes", rr.bytes),`,
+		"obs.RecordRequest(r.Method, metricsEndpoint(r.URL.Path), rr.status, rr.bytes, time.Since(start))",
+	} {
+		if !strings.Contains(middleware, check) {
+			t.Errorf("middleware.go should contain %q", check)
+		}
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, "return middleware.Metrics(next, obs)") {
+		t.Error("stdlib server should install the metrics middleware")
+	}
+}
+
+func TestGenerator_OtelLogs(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Logger = "slog"
+	cfg.EnableTracing = true
+	cfg.OtelLogs = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	logger := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+	for _, check := range []string{
+		`"go.opentelemetry.io/contrib/bridges/otelslog"`,
+		"func NewOTelLogger(ctx context.Context, cfg *config.Config) (*slog.Logger, func(context.Context) error, error) {",
+		"otelslog.WithLoggerProvider(provider)",
+	} {
+		if !strings.Contains(logger, check) {
+			t.Errorf("logger.go should contain %q", check)
+		}
+	}
+
+	obs := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+	for _, check := range []string{
+		"logger, loggerShutdown, err := NewOTelLogger(ctx, cfg)",
+		"_ = o.loggerShutdown(ctx)",
+	} {
+		if !strings.Contains(obs, check) {
+			t.Errorf("observability.go should contain %q", check)
+		}
+	}
+
+	goMod := mfs.FileContent("/output/test-project/go.mod")
+	if !strings.Contains(goMod, "go.opentelemetry.io/contrib/bridges/otelslog") {
+		t.Error("go.mod should require the otelslog bridge")
+	}
+}
+
+func TestGenerator_OtelLogsDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Logger = "slog"
+	cfg.EnableTracing = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, path := range []string{"internal/observability/logger.go", "internal/observability/observability.go", "go.mod"} {
+		if strings.Contains(mfs.FileContent("/output/test-project/"+path), "otelslog") {
+			t.Errorf("%s should not reference otelslog without --otel-logs", path)
+		}
+	}
+}
+
+func TestGenerator_OTLPHeaders(t *testing.T) {
+	for _, format := range []string{"env", "yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.EnableTracing = true
+			cfg.OTLPHeaders = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			headersRef := "cfg.OTLPHeaders"
+			if format != "env" {
+				headersRef = "cfg.GetOTLPHeaders()"
+			}
+			obs := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+			if !strings.Contains(obs, "\t\totlptracegrpc.WithHeaders("+headersRef+"),\n") {
+				t.Error("initTracer should pass the configured OTLP headers to the exporter")
+			}
+			if !strings.Contains(obs, "otlptracegrpc.WithInsecure(),") {
+				t.Error("initTracer should stay insecure without --otlp-secure")
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if format != "env" {
+				config += mfs.FileContent("/output/test-project/internal/config/env.go")
+			}
+			if !strings.Contains(config, `getEnvMap("OTLP_HEADERS")`) {
+				t.Error("config should read OTLP_HEADERS")
+			}
+			if !strings.Contains(config, "func getEnvMap(key string) map[string]string {") {
+				t.Error("config should define getEnvMap")
+			}
+		})
+	}
+}
+
+func TestGenerator_OTLPSecure(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Logger = "slog"
+	cfg.EnableTracing = true
+	cfg.OtelLogs = true
+	cfg.OTLPSecure = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for file, pkg := range map[string]string{"observability.go": "otlptracegrpc", "logger.go": "otlploggrpc"} {
+		content := mfs.FileContent("/output/test-project/internal/observability/" + file)
+		if !strings.Contains(content, pkg+`.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ""))`) {
+			t.Errorf("%s should export over TLS", file)
+		}
+		if strings.Contains(content, pkg+".WithInsecure()") {
+			t.Errorf("%s should not use an insecure connection", file)
+		}
+		if !strings.Contains(content, `"google.golang.org/grpc/credentials"`) {
+			t.Errorf("%s should import grpc credentials", file)
+		}
+	}
+
+	if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "google.golang.org/grpc v") {
+		t.Error("go.mod should require google.golang.org/grpc")
+	}
+}
+
+func TestGenerator_MetricsRouteLabels(t *testing.T) {
+	tests := []struct {
+		framework string
+		install   string
+		endpoint  string
+	}{
+		{"stdlib", "return middleware.Metrics(next, obs)", "metricsEndpoint(r.URL.Path)"},
+		{"chi", "r.Use(custommw.ChiMetrics(obs))", "rctx.RoutePattern()"},
+		{"gin", "r.Use(middleware.GinMetrics(obs))", "c.FullPath()"},
+		{"echo", "s.echo.Use(custommw.EchoMetrics(obs))", "c.Path()"},
+		{"fiber", "s.app.Use(middleware.FiberMetrics(obs))", "c.Route().Path"},
+		{"fasthttp", "h = middleware.FastHTTPMetrics(h, obs)", "metricsEndpoint(string(ctx.Path()))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.EnableMetrics = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.install) {
+				t.Errorf("server.go should install the metrics middleware with %q", tt.install)
+			}
+
+			middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			if !strings.Contains(middleware, tt.endpoint) {
+				t.Errorf("middleware.go should label requests by %q", tt.endpoint)
+			}
+		})
+	}
+}
+
+func TestGenerator_OTelErrorHandler(t *testing.T) {
+	tests := []struct {
+		logger string
+		log    string
+	}{
+		{"slog", `obs.Logger.Error("OpenTelemetry error", "error", err)`},
+		{"zap", `obs.Logger.Error("OpenTelemetry error", zap.Error(err))`},
+		{"zerolog", `obs.Logger.Error().Err(err).Msg("OpenTelemetry error")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = tt.logger
+			cfg.EnableTracing = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			content := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+			if !strings.Contains(content, "otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {") {
+				t.Error("observability.go should set an OpenTelemetry error handler")
+			}
+			if !strings.Contains(content, tt.log) {
+				t.Errorf("the error handler should log with %q", tt.log)
+			}
+		})
+	}
+}
+
+func TestGenerator_OTelErrorHandlerWithoutTracing(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableTracing = false
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/observability/observability.go"), "SetErrorHandler") {
+		t.Error("observability.go should only set an OpenTelemetry error handler with tracing")
+	}
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getOTLPExporterOptions returns the connection options of an OTLP gRPC
+// exporter from the package pkg (e.g., otlptracegrpc), one per line: the
+// endpoint, the transport security, and the OTLP_HEADERS when enabled.
+func (g *Generator) getOTLPExporterOptions(pkg string) string {
+	options := []string{fmt.Sprintf("%s.WithEndpoint(%s)", pkg, g.getConfigFieldReference("OTLPEndpoint"))}
+	if g.config.OTLPSecure {
+		// Verify the collector certificate against the system roots
+		options = append(options, pkg+`.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, ""))`)
+	} else {
+		options = append(options, pkg+".WithInsecure()")
+	}
+	if g.config.OTLPHeaders {
+		options = append(options, fmt.Sprintf("%s.WithHeaders(%s)", pkg, g.getConfigFieldReference("OTLPHeaders")))
+	}
+
+	var sb strings.Builder
+	for _, option := range options {
+		sb.WriteString("\t\t" + option + ",\n")
+	}
+	return sb.String()
+}
+
+// getTracingConfigType returns the structured TracingConfig type, tagged for
+// the given format.
+func (g *Generator) getTracingConfigType(tag string) string {
+	fields := [][3]string{
+		{"Enabled", "bool", "enabled"},
+		{"OTLPEndpoint", "string", "otlp_endpoint"},
+		{"ServiceName", "string", "service_name"},
+		{"SampleRate", "float64", "sample_rate"},
+	}
+	if g.config.OTLPHeaders {
+		fields = append(fields, [3]string{"Headers", "map[string]string", "headers"})
+	}
+
+	typeWidth := 0
+	for _, f := range fields {
+		typeWidth = max(typeWidth, len(f[1]))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("type TracingConfig struct {\n")
+	for _, f := range fields {
+		sb.WriteString(fmt.Sprintf("\t%-12s %-*s %s\n", f[0], typeWidth, f[1], fieldTag(tag, f[2])))
+	}
+	sb.WriteString("}\n\n")
+	return sb.String()
+}
+
+// getOTLPHeadersEnvOverride returns the applyEnvOverrides statement letting
+// OTLP_HEADERS override the structured config's tracing headers.
+func (g *Generator) getOTLPHeadersEnvOverride() string {
+	if !g.config.OTLPHeaders {
+		return ""
+	}
+	return fmt.Sprintf(`
+	if headers := getEnvMap(%q); len(headers) > 0 {
+		c.Observability.Tracing.Headers = headers
+	}`, g.envVar("OTLP_HEADERS"))
+}
+
+// getEnvMapFunc returns the getEnvMap helper reading key=value lists such as
+// OTLP_HEADERS.
+func (g *Generator) getEnvMapFunc() string {
+	if !g.config.OTLPHeaders {
+		return ""
+	}
+	return `
+// getEnvMap returns the comma-separated key=value pairs of the environment
+// variable key (e.g., "api-key=secret,team=web"), skipping malformed pairs.
+func getEnvMap(key string) map[string]string {
+	pairs := map[string]string{}
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if k = strings.TrimSpace(k); ok && k != "" {
+			pairs[k] = strings.TrimSpace(v)
+		}
+	}
+	return pairs
+}
+`
+}
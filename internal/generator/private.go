@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getGoPrivatePattern returns the GOPRIVATE pattern covering the module and
+// its private siblings: the module root, i.e. the host and owner of the
+// module path (github.com/acme for github.com/acme/api), or "" unless
+// private modules are enabled.
+func (g *Generator) getGoPrivatePattern() string {
+	if !g.config.Private {
+		return ""
+	}
+	parts := strings.Split(g.config.ModulePath, "/")
+	if len(parts) < 3 {
+		return parts[0]
+	}
+	return strings.Join(parts[:2], "/")
+}
+
+// getGitHubPrivateModulesStep returns the GitHub Actions step exporting
+// GOPRIVATE to the later steps of a job, or "" unless private modules are
+// enabled.
+func (g *Generator) getGitHubPrivateModulesStep() string {
+	if !g.config.Private {
+		return ""
+	}
+	return fmt.Sprintf(`
+      - name: Configure private modules
+        run: echo "GOPRIVATE=%s" >> "$GITHUB_ENV"
+`, g.getGoPrivatePattern())
+}
+
+// getGitLabPrivateModulesVariable returns the GitLab CI variable setting
+// GOPRIVATE for every job, or "" unless private modules are enabled.
+func (g *Generator) getGitLabPrivateModulesVariable() string {
+	if !g.config.Private {
+		return ""
+	}
+	return fmt.Sprintf("\n  GOPRIVATE: %q", g.getGoPrivatePattern())
+}
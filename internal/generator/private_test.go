@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_PrivateModules(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ModulePath = "git.corp.example/platform/test-project"
+	cfg.Private = true
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	for _, check := range []string{
+		"GOPRIVATE ?= git.corp.example/platform\n",
+		"deps: ## Download dependencies, including private modules\n\t@echo \"Downloading dependencies...\"\n\t@GOPRIVATE=$(GOPRIVATE) go mod download",
+	} {
+		if !strings.Contains(makefile, check) {
+			t.Errorf("Makefile should contain %q", check)
+		}
+	}
+
+	ci := mfs.FileContent("/output/test-project/.github/workflows/ci.yml")
+	step := `echo "GOPRIVATE=git.corp.example/platform" >> "$GITHUB_ENV"`
+	if got := strings.Count(ci, step); got != 3 {
+		t.Errorf("every CI job should set GOPRIVATE, found %d of 3", got)
+	}
+
+	readme := mfs.FileContent("/output/test-project/README.md")
+	if !strings.Contains(readme, "Modules under git.corp.example/platform are private") || !strings.Contains(readme, "~/.netrc") {
+		t.Error("README.md should explain GOPRIVATE and .netrc authentication")
+	}
+}
+
+func TestGenerator_PrivateModulesGitLab(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Private = true
+	cfg.CI = "gitlab"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	ci := mfs.FileContent("/output/test-project/.gitlab-ci.yml")
+	if !strings.Contains(ci, "variables:\n  GO_VERSION: \"1.23\"\n  GOPRIVATE: \"github.com/test\"\n") {
+		t.Errorf("GitLab CI should set GOPRIVATE to the module root, got:\n%s", ci)
+	}
+}
+
+func TestGenerator_PrivateModulesDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, file := range []string{"Makefile", ".github/workflows/ci.yml", "README.md"} {
+		if strings.Contains(mfs.FileContent("/output/test-project/"+file), "GOPRIVATE") {
+			t.Errorf("%s should not mention GOPRIVATE without --private", file)
+		}
+	}
+}
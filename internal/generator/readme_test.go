@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ReadmeBadges(t *testing.T) {
+	tests := []struct {
+		name       string
+		modulePath string
+		ci         string
+		want       []string
+		notWant    []string
+	}{
+		{
+			name:       "github with GitHub Actions",
+			modulePath: "github.com/test/test-project/v2",
+			ci:         "github",
+			want: []string{
+				"[![CI](https://github.com/test/test-project/actions/workflows/ci.yml/badge.svg)](https://github.com/test/test-project/actions/workflows/ci.yml)",
+				"[![codecov](https://codecov.io/gh/test/test-project/branch/main/graph/badge.svg)](https://codecov.io/gh/test/test-project)",
+			},
+			notWant: []string{"pipeline status"},
+		},
+		{
+			name:       "gitlab subgroup with GitLab CI",
+			modulePath: "gitlab.com/group/sub/test-project/v3",
+			ci:         "gitlab",
+			want: []string{
+				"[![pipeline status](https://gitlab.com/group/sub/test-project/badges/main/pipeline.svg)](https://gitlab.com/group/sub/test-project/-/commits/main)",
+			},
+			notWant: []string{"[![CI]", "codecov"},
+		},
+		{
+			name:       "github without CI",
+			modulePath: "github.com/test/test-project",
+			notWant:    []string{"[![CI]", "codecov", "pipeline status"},
+		},
+		{
+			name:       "self-hosted module path",
+			modulePath: "example.com/test-project",
+			ci:         "github,gitlab",
+			notWant:    []string{"[![CI]", "codecov", "pipeline status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.GoVersion = "1.22"
+			cfg.ModulePath = tt.modulePath
+			cfg.CI = tt.ci
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			readme := mfs.FileContent("/output/test-project/README.md")
+			if !strings.Contains(readme, "![Go Version](https://img.shields.io/badge/go-1.22-00ADD8?logo=go)") {
+				t.Error("README should have a badge for the configured Go version")
+			}
+			if !strings.Contains(readme, "![License: MIT](https://img.shields.io/badge/license-MIT-blue.svg)") {
+				t.Error("README should have a license badge")
+			}
+			for _, badge := range tt.want {
+				if !strings.Contains(readme, badge) {
+					t.Errorf("README should contain %q", badge)
+				}
+			}
+			for _, badge := range tt.notWant {
+				if strings.Contains(readme, badge) {
+					t.Errorf("README should not contain %q", badge)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"fmt"
+	"net"
+)
+
+// getTrustedProxiesExpr returns the []string literal of the trusted proxy
+// CIDRs, with bare IPs widened to single-address CIDRs, or "" when no proxies
+// are trusted.
+func (g *Generator) getTrustedProxiesExpr() string {
+	if len(g.config.TrustedProxies) == 0 {
+		return ""
+	}
+	cidrs := make([]string, 0, len(g.config.TrustedProxies))
+	for _, proxy := range g.config.TrustedProxies {
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			cidrs = append(cidrs, network.String())
+			continue
+		}
+		ip := net.ParseIP(proxy)
+		if ip.To4() != nil {
+			cidrs = append(cidrs, ip.String()+"/32")
+		} else {
+			cidrs = append(cidrs, ip.String()+"/128")
+		}
+	}
+	return fmt.Sprintf("%#v", cidrs)
+}
+
+// getRealIPImports returns the middleware.go imports of the trusted-proxy
+// client IP resolution, or nil when no proxies are trusted.
+func (g *Generator) getRealIPImports() []string {
+	if len(g.config.TrustedProxies) == 0 {
+		return nil
+	}
+	switch g.config.Framework {
+	case "stdlib", "chi":
+		return []string{`"net"`, `"net/netip"`, `"strings"`}
+	case "echo":
+		return []string{`"net"`}
+	default:
+		return nil
+	}
+}
+
+// getRealIPMiddleware returns the middleware resolving the client IP from
+// X-Forwarded-For only behind trusted proxies, or "" when no proxies are
+// trusted or the framework checks proxies itself (gin and fiber).
+func (g *Generator) getRealIPMiddleware() string {
+	if len(g.config.TrustedProxies) == 0 {
+		return ""
+	}
+
+	switch g.config.Framework {
+	case "stdlib", "chi":
+		return `
+// RealIP sets r.RemoteAddr to the client address from X-Forwarded-For, but
+// only for requests arriving from one of the trusted proxy CIDRs so that
+// clients cannot spoof their address. Addresses appended by trusted proxies
+// are skipped from the right up to the first untrusted one.
+func RealIP(trustedProxies []string) func(next http.Handler) http.Handler {
+	prefixes := make([]netip.Prefix, len(trustedProxies))
+	for i, cidr := range trustedProxies {
+		prefixes[i] = netip.MustParsePrefix(cidr)
+	}
+	trusted := func(addr netip.Addr) bool {
+		addr = addr.Unmap()
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := forwardedClientIP(r, trusted); ip != "" {
+				r.RemoteAddr = ip
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClientIP returns the client address in X-Forwarded-For, or ""
+// when the request did not come from a trusted proxy.
+func forwardedClientIP(r *http.Request, trusted func(netip.Addr) bool) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if peer, err := netip.ParseAddr(host); err != nil || !trusted(peer) {
+		return ""
+	}
+
+	client := ""
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		client = addr.Unmap().String()
+		if !trusted(addr) {
+			break
+		}
+	}
+	return client
+}
+`
+	case "echo":
+		return `
+// EchoIPExtractor returns the echo IPExtractor reading the client address
+// from X-Forwarded-For only for requests arriving from the trusted proxy
+// CIDRs. Unlike echo's defaults, private and loopback networks are not
+// trusted unless listed.
+func EchoIPExtractor(trustedProxies []string) echo.IPExtractor {
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range trustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		options = append(options, echo.TrustIPRange(network))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+`
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_TrustedProxies(t *testing.T) {
+	tests := []struct {
+		framework string
+		server    string
+	}{
+		{"stdlib", `middleware.RealIP([]string{"10.0.0.0/8", "192.168.1.10/32"}),`},
+		{"chi", `r.Use(custommw.RealIP([]string{"10.0.0.0/8", "192.168.1.10/32"}))`},
+		{"gin", `if err := r.SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.10/32"}); err != nil {`},
+		{"echo", `s.echo.IPExtractor = custommw.EchoIPExtractor([]string{"10.0.0.0/8", "192.168.1.10/32"})`},
+		{"fiber", `TrustedProxies:          []string{"10.0.0.0/8", "192.168.1.10/32"},`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.TrustedProxies = []string{"10.0.0.0/8", "192.168.1.10"}
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.server) {
+				t.Errorf("server.go should configure the trusted proxies with %q", tt.server)
+			}
+			if strings.Contains(server, "r.Use(middleware.RealIP)") {
+				t.Error("server.go should not trust X-Forwarded-For from every peer")
+			}
+		})
+	}
+}
+
+func TestGenerator_TrustedProxiesDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "gin"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "SetTrustedProxies") {
+		t.Error("gin server should keep its defaults without trusted proxies")
+	}
+}
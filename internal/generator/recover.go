@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getRecovererFunc returns the net/http Recoverer. With --disable-recover it
+// takes the environment and re-panics outside production, so panics fail
+// tests and surface in development instead of becoming a quiet 500. With
+// metrics it takes the Observability and counts each panic.
+func (g *Generator) getRecovererFunc(loggerType string) string {
+	if !g.config.DisableRecover && !g.config.EnableMetrics {
+		return fmt.Sprintf(`func Recoverer(next http.Handler, logger %s) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+`, loggerType)
+	}
+
+	doc := "// Recoverer answers requests whose handler panics with a 500.\n"
+	if g.config.DisableRecover {
+		doc = `// Recoverer answers requests whose handler panics with a 500 in production.
+// In other environments it re-panics, so panics fail tests and show up with
+// their stack trace in development.
+`
+	}
+	doc += g.getPanicsCountedDoc()
+
+	return fmt.Sprintf(`%sfunc Recoverer(next http.Handler, logger %s%s) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+%s				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+`, doc, loggerType, g.getRecovererParams(), g.getRecovererPanicHandling())
+}
+
+// getFastHTTPRecovererFunc returns FastHTTPRecoverer, re-panicking outside
+// production with --disable-recover and counting panics with metrics like the
+// net/http Recoverer. fasthttp recovers nothing itself, so a re-panic stops
+// the process.
+func (g *Generator) getFastHTTPRecovererFunc() string {
+	if !g.config.DisableRecover && !g.config.EnableMetrics {
+		return `
+func FastHTTPRecoverer(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		defer func() {
+			if err := recover(); err != nil {
+				ctx.Error(fasthttp.StatusMessage(fasthttp.StatusInternalServerError), fasthttp.StatusInternalServerError)
+			}
+		}()
+		next(ctx)
+	}
+}
+`
+	}
+
+	doc := "// FastHTTPRecoverer answers requests whose handler panics with a 500.\n"
+	if g.config.DisableRecover {
+		doc = `// FastHTTPRecoverer answers requests whose handler panics with a 500 in
+// production. In other environments it re-panics, stopping the process so
+// the panic can't go unnoticed.
+`
+	}
+	doc += g.getPanicsCountedDoc()
+
+	return fmt.Sprintf(`
+%sfunc FastHTTPRecoverer(next fasthttp.RequestHandler%s) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		defer func() {
+			if err := recover(); err != nil {
+%s				ctx.Error(fasthttp.StatusMessage(fasthttp.StatusInternalServerError), fasthttp.StatusInternalServerError)
+			}
+		}()
+		next(ctx)
+	}
+}
+`, doc, g.getRecovererParams(), g.getRecovererPanicHandling())
+}
+
+// getPanicsCountedDoc returns the doc comment line of a generated Recoverer
+// noting that it counts panics, or "" without metrics.
+func (g *Generator) getPanicsCountedDoc() string {
+	if !g.config.EnableMetrics {
+		return ""
+	}
+	return "// Every panic is counted in http_panics_total.\n"
+}
+
+// getRecovererParams returns the trailing parameters of the generated
+// Recoverers: the environment with --disable-recover, and the Observability
+// counting panics with metrics.
+func (g *Generator) getRecovererParams() string {
+	var params string
+	if g.config.DisableRecover {
+		params += ", environment string"
+	}
+	if g.config.EnableMetrics {
+		params += ", obs *observability.Observability"
+	}
+	return params
+}
+
+// getRecovererPanicHandling returns the statements a generated Recoverer runs
+// on a panic before answering it. The panic is counted before a re-panic so
+// that it's counted in every environment.
+func (g *Generator) getRecovererPanicHandling() string {
+	const indent = "\t\t\t\t"
+	var stmts string
+	if g.config.EnableMetrics {
+		stmts += indent + "obs.RecordPanic()\n"
+	}
+	if g.config.DisableRecover {
+		stmts += indent + "if environment != \"production\" {\n" + indent + "\tpanic(err)\n" + indent + "}\n"
+	}
+	return stmts
+}
+
+// getRecovererTests returns the generated tests of the net/http Recoverer in
+// each environment, or "" without --disable-recover.
+func (g *Generator) getRecovererTests(loggerInit string) string {
+	if !g.config.DisableRecover || g.config.ExportMiddleware {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+func TestRecoverer_RepanicsOutsideProduction(t *testing.T) {
+	logger := %[1]s
+	h := Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), logger, "development"%[2]s)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func TestRecoverer_RecoversInProduction(t *testing.T) {
+	logger := %[1]s
+	h := Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), logger, "production"%[2]s)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+`, loggerInit, g.getRecovererTestObs())
+}
+
+// getRecovererTestObs returns the trailing argument passing the shared
+// Observability to Recoverer in the generated tests, or "" without metrics.
+func (g *Generator) getRecovererTestObs() string {
+	if !g.config.EnableMetrics {
+		return ""
+	}
+	return ", newObservability(t)"
+}
+
+// getPanicMetricsTests returns the generated test checking that the net/http
+// Recoverer counts panics, with the helpers sharing one Observability across
+// the tests, or "" without metrics. With the subset exported it checks the
+// httpmw.RecovererWith(obs.RecordPanic) the server recovers with instead.
+func (g *Generator) getPanicMetricsTests(loggerInit string) string {
+	if !g.config.EnableMetrics {
+		return ""
+	}
+
+	var env string
+	if g.config.DisableRecover {
+		env = `, "production"`
+	}
+	countsPanics := fmt.Sprintf(`func TestRecoverer_CountsPanics(t *testing.T) {
+	logger := %s
+	obs := newObservability(t)
+	h := Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), logger%s, obs)
+`, loggerInit, env)
+	if g.config.ExportMiddleware {
+		countsPanics = `func TestRecovererWith_CountsPanics(t *testing.T) {
+	obs := newObservability(t)
+	// The server recovers with the same middleware
+	h := httpmw.RecovererWith(obs.RecordPanic)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+`
+	}
+
+	return fmt.Sprintf(`
+var sharedObservability = sync.OnceValues(func() (*observability.Observability, error) {
+	return observability.New(context.Background(), &config.Config{
+%s
+	})
+})
+
+// newObservability returns the Observability shared by the tests: New
+// registers the metrics with the default Prometheus registry, which rejects
+// duplicates.
+func newObservability(t *testing.T) *observability.Observability {
+	t.Helper()
+	obs, err := sharedObservability()
+	require.NoError(t, err)
+	return obs
+}
+
+// panicsTotal scrapes the current value of http_panics_total from obs.
+func panicsTotal(t *testing.T, obs *observability.Observability) float64 {
+	t.Helper()
+	w := httptest.NewRecorder()
+	obs.MetricsHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		name, value, ok := strings.Cut(line, " ")
+		if ok && strings.HasSuffix(name, "http_panics_total") {
+			total, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			return total
+		}
+	}
+	t.Fatal("http_panics_total not found in the metrics")
+	return 0
+}
+
+%s
+	before := panicsTotal(t, obs)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, before+1, panicsTotal(t, obs))
+}
+`, g.getTestConfigFields(), countsPanics)
+}
@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_DisableRecoverRepanics(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.DisableRecover = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	for _, want := range []string{
+		"func Recoverer(next http.Handler, logger *slog.Logger, environment string) http.Handler {",
+		"if environment != \"production\" {\n\t\t\t\t\tpanic(err)\n\t\t\t\t}",
+	} {
+		if !strings.Contains(middleware, want) {
+			t.Errorf("middleware.go should contain %q", want)
+		}
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, "middleware.Recoverer(next, obs.Logger, cfg.Environment)") {
+		t.Error("server.go should pass the environment to Recoverer")
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	if !strings.Contains(tests, `}), logger, "development")`+"\n\n\tassert.PanicsWithValue(t, \"boom\"") {
+		t.Error("middleware_test.go should assert Recoverer re-panics in development")
+	}
+}
+
+func TestGenerator_DisableRecoverFrameworks(t *testing.T) {
+	tests := []struct {
+		framework string
+		want      string
+	}{
+		{"chi", "if cfg.Environment == \"production\" {\n\t\tr.Use(middleware.Recoverer)\n\t}"},
+		{"gin", "if cfg.Environment == \"production\" {\n\t\tr.Use(gin.Recovery())\n\t}"},
+		{"echo", "if cfg.Environment == \"production\" {\n\t\ts.echo.Use(middleware.Recover())\n\t}"},
+		{"fiber", "if cfg.Environment == \"production\" {\n\t\ts.app.Use(recover.New())\n\t}"},
+		{"fasthttp", "h = middleware.FastHTTPRecoverer(h, cfg.Environment)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.DisableRecover = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.want) {
+				t.Errorf("server.go should contain %q", tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_RecoverByDefault(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if strings.Contains(middleware, "panic(err)") {
+		t.Error("Recoverer should not re-panic without --disable-recover")
+	}
+}
+
+func TestGenerator_RecovererCountsPanics(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableMetrics = true
+	cfg.EnableTracing = true
+	cfg.DisableRecover = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	for _, want := range []string{
+		"func Recoverer(next http.Handler, logger *slog.Logger, environment string, obs *observability.Observability) http.Handler {",
+		"obs.RecordPanic()\n\t\t\t\tif environment != \"production\" {",
+	} {
+		if !strings.Contains(middleware, want) {
+			t.Errorf("middleware.go should contain %q", want)
+		}
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, "middleware.Recoverer(next, obs.Logger, cfg.Environment, obs)") {
+		t.Error("server.go should pass the Observability to Recoverer")
+	}
+
+	observability := mfs.FileContent("/output/test-project/internal/observability/observability.go")
+	for _, want := range []string{`Name: "http_panics_total",`, `Name: "http_errors_total",`, "func (o *Observability) RecordPanic() {"} {
+		if !strings.Contains(observability, want) {
+			t.Errorf("observability.go should contain %q", want)
+		}
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	for _, want := range []string{
+		"func TestRecoverer_CountsPanics(t *testing.T) {",
+		"assert.Equal(t, before+1, panicsTotal(t, obs))",
+		`}), logger, "development", newObservability(t))`,
+		// New fails to build the OTLP exporter without an endpoint
+		`OTLPEndpoint: "localhost:4317",`,
+	} {
+		if !strings.Contains(tests, want) {
+			t.Errorf("middleware_test.go should contain %q", want)
+		}
+	}
+}
+
+func TestGenerator_FrameworkRecoveryCountsPanics(t *testing.T) {
+	tests := []struct {
+		framework string
+		want      string
+	}{
+		{"chi", "r.Use(middleware.Recoverer)\n\tr.Use(custommw.ChiPanicMetrics(obs))"},
+		{"gin", "defer countPanic(obs)\n\t\tc.Next()"},
+		{"echo", "defer countPanic(obs)\n\t\t\terr := next(c)"},
+		{"fiber", "defer countPanic(obs)\n\t\terr := c.Next()"},
+		{"fasthttp", "h = middleware.FastHTTPRecoverer(h, obs)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.EnableMetrics = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			generated := mfs.FileContent("/output/test-project/internal/middleware/middleware.go") +
+				mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(generated, tt.want) {
+				t.Errorf("the generated middleware or server should contain %q", tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Replace(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Replaces = []string{
+		"github.com/foo/bar=../bar",
+		"github.com/foo/baz@v1.2.0=github.com/fork/baz@v1.2.1",
+	}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	goMod := mfs.FileContent("/output/test-project/go.mod")
+	want := "\nreplace (\n" +
+		"\tgithub.com/foo/bar => ../bar\n" +
+		"\tgithub.com/foo/baz v1.2.0 => github.com/fork/baz v1.2.1\n" +
+		")\n"
+	if !strings.HasSuffix(goMod, want) {
+		t.Errorf("go.mod should end with the replace block %q, got:\n%s", want, goMod)
+	}
+}
+
+func TestGenerator_NoReplace(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "replace") {
+		t.Error("go.mod should have no replace block without --replace")
+	}
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// route is an HTTP route of the generated app. g.routes() is the single
+// source of truth for the server registrations and the API documentation,
+// so they cannot drift apart.
+type route struct {
+	method  string // HTTP method, e.g. GET
+	path    string
+	handler string // Handler method name, without the framework suffix
+	summary string
+	example string // Example JSON request body, empty for requests without one
+}
+
+// routes returns the routes registered by the generated server, in
+// registration order, based on the enabled features.
+func (g *Generator) routes() []route {
+	routes := []route{
+		{method: "GET", path: "/health", handler: "Health", summary: "Health check"},
+		{method: "GET", path: "/ready", handler: "Ready", summary: "Readiness check"},
+	}
+	if g.config.ProbeAliases {
+		routes = append(routes,
+			route{method: "GET", path: "/livez", handler: "Health", summary: "Liveness probe (alias of /health)"},
+			route{method: "GET", path: "/readyz", handler: "Ready", summary: "Readiness probe (alias of /ready)"},
+		)
+	}
+	if g.config.HealthDetail {
+		routes = append(routes, route{method: "GET", path: "/healthz/detailed", handler: "HealthDetailed", summary: "Health check with uptime, Go version, and build info"})
+	}
+	routes = append(routes, route{method: "GET", path: "/", handler: "Index", summary: "Welcome message"})
+	if g.config.Validator {
+		routes = append(routes, route{method: "POST", path: "/users", handler: "CreateUser", summary: "Create a user from a validated JSON body", example: `{"name": "Ada Lovelace", "email": "ada@example.com"}`})
+	}
+	if g.config.EnableWebSocket {
+		routes = append(routes, route{method: "GET", path: "/ws", handler: "WebSocket", summary: "WebSocket echoing every message back"})
+	}
+	if g.config.NeedsCache() {
+		routes = append(routes, route{method: "GET", path: "/cached", handler: "Cached", summary: "Value read through the cache (cache-aside example)"})
+	}
+	if g.config.EnableMetrics {
+		routes = append(routes, route{method: "GET", path: "/metrics", handler: "Metrics", summary: "Prometheus metrics"})
+	}
+	return routes
+}
+
+// getRouteRegistrations returns the statements registering g.routes() with
+// the configured framework's router, one per route, without indentation.
+func (g *Generator) getRouteRegistrations() []string {
+	return g.framework().RouteRegistrations(g.routes())
+}
+
+// getAPIEndpoints returns the README list of g.routes().
+func (g *Generator) getAPIEndpoints() string {
+	lines := make([]string, 0, len(g.routes()))
+	for _, r := range g.routes() {
+		lines = append(lines, fmt.Sprintf("- `%s %s` - %s", r.method, r.path, r.summary))
+	}
+	return strings.Join(lines, "\n")
+}
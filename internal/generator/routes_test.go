@@ -0,0 +1,51 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_RoutesMetrics(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		cfg := createTestConfig()
+		cfg.EnableMetrics = enabled
+		gen, _ := createTestGenerator(cfg)
+
+		found := false
+		for _, r := range gen.routes() {
+			if r.path == "/metrics" {
+				found = true
+			}
+		}
+		if found != enabled {
+			t.Errorf("EnableMetrics=%v: routes() includes /metrics = %v", enabled, found)
+		}
+	}
+}
+
+func TestGenerator_RoutesDriveServerAndReadme(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.Validator = true
+			cfg.EnableMetrics = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			readme := mfs.FileContent("/output/test-project/README.md")
+			for _, r := range gen.routes() {
+				if !strings.Contains(server, `"`+r.path+`"`) {
+					t.Errorf("server.go should register %s", r.path)
+				}
+				if endpoint := "`" + r.method + " " + r.path + "`"; !strings.Contains(readme, endpoint) {
+					t.Errorf("README.md should document %s", endpoint)
+				}
+			}
+		})
+	}
+}
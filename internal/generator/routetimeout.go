@@ -0,0 +1,141 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultRouteTimeout is the request timeout of the paths --timeout-per-route
+// leaves out, the one chi and echo apply to every request without it.
+const defaultRouteTimeout = 60 * time.Second
+
+// getRouteTimeoutMiddleware returns the net/http RouteTimeout middleware, also
+// used by chi and, wrapped, by echo, or "" without --timeout-per-route.
+func (g *Generator) getRouteTimeoutMiddleware() string {
+	if len(g.config.RouteTimeouts) == 0 {
+		return ""
+	}
+	return `
+// RouteTimeout cancels the context of each request once the timeout of its
+// path in routes, or fallback for the other paths, has passed. Like chi's
+// middleware.Timeout, it answers 504 when the handler returns after the
+// deadline. The write deadline moves along, so timeouts beyond the server's
+// WriteTimeout still get their response written.
+func RouteTimeout(fallback time.Duration, routes map[string]time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout, ok := routes[r.URL.Path]
+			if !ok {
+				timeout = fallback
+			}
+
+			// Leave a second past the timeout to write the response, 504
+			// included. Writers that can't set deadlines keep the server's.
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout + time.Second))
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer func() {
+				cancel()
+				if ctx.Err() == context.DeadlineExceeded {
+					w.WriteHeader(http.StatusGatewayTimeout)
+				}
+			}()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+`
+}
+
+// getRouteTimeoutPaths returns the paths of --timeout-per-route, sorted so
+// the generated code is stable.
+func (g *Generator) getRouteTimeoutPaths() []string {
+	paths := make([]string, 0, len(g.config.RouteTimeouts))
+	for path := range g.config.RouteTimeouts {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// getRouteTimeoutExpr returns the RouteTimeout call of the server, qualified
+// with pkg, or "" without --timeout-per-route.
+func (g *Generator) getRouteTimeoutExpr(pkg string) string {
+	if len(g.config.RouteTimeouts) == 0 {
+		return ""
+	}
+
+	entries := make([]string, 0, len(g.config.RouteTimeouts))
+	for _, path := range g.getRouteTimeoutPaths() {
+		entries = append(entries, fmt.Sprintf("%q: %s", path, serverDurationLiteral(g.config.RouteTimeouts[path])))
+	}
+	return fmt.Sprintf("%s.RouteTimeout(%s, map[string]time.Duration{%s})",
+		pkg, serverDurationLiteral(defaultRouteTimeout), strings.Join(entries, ", "))
+}
+
+// getRouteTimeoutDescription returns how the README lists the per-route
+// timeouts, e.g. "`/health` 2s, `/reports` 2m0s".
+func (g *Generator) getRouteTimeoutDescription() string {
+	descriptions := make([]string, 0, len(g.config.RouteTimeouts))
+	for _, path := range g.getRouteTimeoutPaths() {
+		descriptions = append(descriptions, fmt.Sprintf("`%s` %s", path, g.config.RouteTimeouts[path]))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// getRouteTimeoutMiddlewareTests returns the generated tests of the net/http
+// RouteTimeout middleware, or "" without --timeout-per-route.
+func (g *Generator) getRouteTimeoutMiddlewareTests() string {
+	if len(g.config.RouteTimeouts) == 0 {
+		return ""
+	}
+	return `
+func TestRouteTimeout_AppliesTimeoutOfPath(t *testing.T) {
+	var remaining time.Duration
+	h := RouteTimeout(time.Minute, map[string]time.Duration{"/reports": 5 * time.Second})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, ok := r.Context().Deadline()
+		assert.True(t, ok)
+		remaining = time.Until(deadline)
+	}))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/reports", nil))
+	assert.InDelta(t, 5*time.Second, remaining, float64(time.Second))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/other", nil))
+	assert.InDelta(t, time.Minute, remaining, float64(time.Second))
+}
+
+func TestRouteTimeout_AnswersGatewayTimeoutPastDeadline(t *testing.T) {
+	h := RouteTimeout(time.Minute, map[string]time.Duration{"/reports": time.Millisecond})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports", nil))
+
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+}
+
+func TestRouteTimeout_OutlastsServerWriteTimeout(t *testing.T) {
+	h := RouteTimeout(time.Minute, map[string]time.Duration{"/reports": 2 * time.Minute})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("done"))
+	}))
+	srv := httptest.NewUnstartedServer(h)
+	srv.Config.WriteTimeout = 50 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/reports")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "done", string(body))
+}
+`
+}
@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerator_RouteTimeout(t *testing.T) {
+	tests := []struct {
+		framework  string
+		registered string
+		replaced   string
+	}{
+		{"stdlib", `middleware.RouteTimeout(60 * time.Second, map[string]time.Duration{"/health": 2 * time.Second, "/reports": 120 * time.Second}),`, ""},
+		{"chi", `r.Use(custommw.RouteTimeout(60 * time.Second, map[string]time.Duration{"/health": 2 * time.Second, "/reports": 120 * time.Second}))`, "middleware.Timeout("},
+		{"echo", `s.echo.Use(echo.WrapMiddleware(custommw.RouteTimeout(60 * time.Second, map[string]time.Duration{"/health": 2 * time.Second, "/reports": 120 * time.Second})))`, "middleware.TimeoutWithConfig("},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.RouteTimeouts = map[string]time.Duration{"/reports": 2 * time.Minute, "/health": 2 * time.Second}
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			if !strings.Contains(middleware, "func RouteTimeout(fallback time.Duration, routes map[string]time.Duration) Middleware {") {
+				t.Error("middleware.go should define RouteTimeout")
+			}
+			if !strings.Contains(middleware, "ctx, cancel := context.WithTimeout(r.Context(), timeout)") {
+				t.Error("RouteTimeout should bound the request context by the timeout of its path")
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.registered) {
+				t.Errorf("server.go should apply the configured timeouts with %q", tt.registered)
+			}
+			if tt.replaced != "" && strings.Contains(server, tt.replaced) {
+				t.Errorf("server.go should replace %q with RouteTimeout", tt.replaced)
+			}
+
+			readme := mfs.FileContent("/output/test-project/README.md")
+			if !strings.Contains(readme, "`/health` 2s, `/reports` 2m0s") {
+				t.Error("README should list the per-route timeouts")
+			}
+		})
+	}
+}
+
+func TestGenerator_RouteTimeoutTests(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.RouteTimeouts = map[string]time.Duration{"/reports": 30 * time.Second}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	for _, want := range []string{"func TestRouteTimeout_AppliesTimeoutOfPath(t *testing.T) {", "func TestRouteTimeout_AnswersGatewayTimeoutPastDeadline(t *testing.T) {", `"time"`} {
+		if !strings.Contains(tests, want) {
+			t.Errorf("middleware_test.go should contain %q", want)
+		}
+	}
+}
+
+func TestGenerator_RouteTimeoutBeyondWriteTimeout(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.RouteTimeouts = map[string]time.Duration{"/reports": 2 * time.Minute}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// /reports outlasts the server's 15s WriteTimeout, so RouteTimeout has
+	// to extend the write deadline for its response to be written
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "WriteTimeout:      15 * time.Second,") {
+		t.Fatal("server.go should keep its 15s WriteTimeout")
+	}
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	for _, want := range []string{
+		"http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout + time.Second))",
+		"func (r *responseRecorder) Unwrap() http.ResponseWriter {",
+	} {
+		if !strings.Contains(middleware, want) {
+			t.Errorf("middleware.go should contain %q", want)
+		}
+	}
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	if !strings.Contains(tests, "func TestRouteTimeout_OutlastsServerWriteTimeout(t *testing.T) {") {
+		t.Error("middleware_test.go should check a response written past the server's WriteTimeout")
+	}
+}
+
+func TestGenerator_NoRouteTimeout(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "chi"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/middleware/middleware.go"), "RouteTimeout") {
+		t.Error("middleware.go should not define RouteTimeout without --timeout-per-route")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "r.Use(middleware.Timeout(60 * time.Second))") {
+		t.Error("chi should keep its 60s timeout without --timeout-per-route")
+	}
+}
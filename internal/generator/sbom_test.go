@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_SBOM(t *testing.T) {
+	tests := []struct {
+		ci   string
+		path string
+		job  string
+	}{
+		{"github", ".github/workflows/ci.yml", "uses: anchore/sbom-action@v0"},
+		{"gitlab", ".gitlab-ci.yml", "- /syft . -o spdx-json=sbom.spdx.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ci, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.CI = tt.ci
+			cfg.SBOM = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			makefile := mfs.FileContent("/output/test-project/Makefile")
+			if !strings.Contains(makefile, "\nsbom: ## ") || !strings.Contains(makefile, "@syft . -o spdx-json=sbom.spdx.json") {
+				t.Error("Makefile should define an sbom target running syft")
+			}
+			if !strings.Contains(mfs.FileContent("/output/test-project/"+tt.path), tt.job) {
+				t.Errorf("%s should define an sbom job", tt.path)
+			}
+			if !strings.Contains(mfs.FileContent("/output/test-project/.gitignore"), "sbom.spdx.json") {
+				t.Error(".gitignore should ignore the generated SBOM")
+			}
+		})
+	}
+}
+
+func TestGenerator_SBOMDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "sbom") {
+		t.Error("Makefile should not mention sbom unless --sbom is set")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/.github/workflows/ci.yml"), "sbom") {
+		t.Error("ci.yml should not define an sbom job unless --sbom is set")
+	}
+}
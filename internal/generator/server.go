@@ -1,30 +1,58 @@
 package generator
 
+import (
+	"fmt"
+	"time"
+)
+
 // generateServerPackage generates the server package using embedded templates.
 func (g *Generator) generateServerPackage() error {
 	data := ServerTemplateData{
-		ModulePath:    g.config.ModulePath,
-		PortRef:       g.getConfigFieldReference("Port"),
-		EnvRef:        g.getConfigFieldReference("Environment"),
-		EnableTracing: g.config.EnableTracing,
-		EnableMetrics: g.config.EnableMetrics,
+		ModulePath:     g.config.ModulePath,
+		PortRef:        g.getConfigFieldReference("Port"),
+		EnvRef:         g.getConfigFieldReference("Environment"),
+		EnableTracing:  g.config.EnableTracing,
+		EnableMetrics:  g.config.EnableMetrics,
+		SlowRequestRef: g.getConfigFieldReference("SlowRequestThreshold"),
+		Routes:         g.getRouteRegistrations(),
+		NeedsCache:     g.config.NeedsCache(),
+		NeedsDatabase:  g.config.NeedsSQL() || g.config.NeedsNoSQL(),
+
+		DependencyFields: g.getServerDependencyFields(),
+		OptionFuncs:      g.getServerOptionFuncs(),
+
+		ExportMiddleware: g.config.ExportMiddleware,
+		DisableRecover:   g.config.DisableRecover,
+		HTTP2:            g.config.HTTP2,
+		EnableWebSocket:  g.config.EnableWebSocket,
+
+		TrustedProxies: g.getTrustedProxiesExpr(),
+		MaxInflight:    g.getMaxInflightRef(),
+		TraceIDHeader:  g.getTraceIDHeaderExpr(),
+
+		ReadHeaderTimeout: serverDurationLiteral(g.config.AppReadHeaderTimeout()),
+		MaxHeaderBytes:    g.config.MaxHeaderBytes,
+
+		MaxConcurrentStreams: g.getMaxConcurrentStreamsRef(),
+	}
+	// chi and echo import the custom middleware as custommw
+	pkg := "middleware"
+	if g.config.Framework == "chi" || g.config.Framework == "echo" {
+		pkg = "custommw"
+	}
+	if g.config.EnableCORS {
+		data.CORSPolicy = g.getCORSPolicyExpr(pkg)
 	}
+	data.RouteTimeout = g.getRouteTimeoutExpr(pkg)
 
-	templateName := g.getServerTemplateName()
-	return g.writeEmbeddedTemplate("internal/server/server.go", templateName, data)
+	return g.writeEmbeddedTemplate("internal/server/server.go", g.framework().ServerTemplate(), data)
 }
 
-func (g *Generator) getServerTemplateName() string {
-	switch g.config.Framework {
-	case "chi":
-		return "server_chi.go.tmpl"
-	case "gin":
-		return "server_gin.go.tmpl"
-	case "echo":
-		return "server_echo.go.tmpl"
-	case "fiber":
-		return "server_fiber.go.tmpl"
-	default:
-		return "server_stdlib.go.tmpl"
+// serverDurationLiteral returns the Go expression of d in the style of the
+// server timeouts, e.g. 5 * time.Second.
+func serverDurationLiteral(d time.Duration) string {
+	if d%time.Second == 0 {
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
 	}
+	return durationLiteral(d)
 }
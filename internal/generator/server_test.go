@@ -0,0 +1,294 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerator_ProbeAliases(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "stdlib"
+	cfg.ProbeAliases = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	for _, route := range []string{
+		`mux.HandleFunc("/livez", handler.Health)`,
+		`mux.HandleFunc("/readyz", handler.Ready)`,
+	} {
+		if !strings.Contains(server, route) {
+			t.Errorf("server.go should register %s", route)
+		}
+	}
+}
+
+func TestGenerator_ProbeAliases_AllFrameworks(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		t.Run(framework, func(t *testing.T) {
+			for _, enabled := range []bool{true, false} {
+				cfg := createTestConfig()
+				cfg.Framework = framework
+				cfg.ProbeAliases = enabled
+				gen, mfs := createTestGenerator(cfg)
+
+				if err := gen.Generate(); err != nil {
+					t.Fatalf("Generate failed: %v", err)
+				}
+
+				server := mfs.FileContent("/output/test-project/internal/server/server.go")
+				for _, path := range []string{`"/livez"`, `"/readyz"`} {
+					if strings.Contains(server, path) != enabled {
+						t.Errorf("ProbeAliases=%v: unexpected presence of %s route", enabled, path)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_HTTP2(t *testing.T) {
+	for framework, handler := range map[string]string{"stdlib": "h", "chi": "r"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.HTTP2 = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			for _, check := range []string{
+				`"golang.org/x/net/http2/h2c"`,
+				"h2s := &http2.Server{MaxConcurrentStreams: uint32(cfg.HTTP2MaxConcurrentStreams)}",
+				"Handler:           h2c.NewHandler(" + handler + ", h2s),",
+				"http2.ConfigureServer(s.httpServer, h2s)",
+			} {
+				if !strings.Contains(server, check) {
+					t.Errorf("server.go should contain %q", check)
+				}
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "golang.org/x/net") {
+				t.Error("go.mod should require golang.org/x/net")
+			}
+		})
+	}
+}
+
+func TestGenerator_HTTP2MaxConcurrentStreams(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.HTTP2 = true
+			cfg.MaxConcurrentStreams = 500
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, `cfg.HTTP2MaxConcurrentStreams = getEnvInt("HTTP2_MAX_CONCURRENT_STREAMS", 500)`) {
+				t.Error("config.go should read HTTP2_MAX_CONCURRENT_STREAMS, defaulting to --http2-max-concurrent-streams")
+			}
+			if !strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "\nHTTP2_MAX_CONCURRENT_STREAMS=500\n") {
+				t.Error(".env.example should document HTTP2_MAX_CONCURRENT_STREAMS")
+			}
+			if !strings.Contains(mfs.FileContent("/output/test-project/README.md"), "at most HTTP2_MAX_CONCURRENT_STREAMS concurrent streams per connection (default 500)") {
+				t.Error("README should document the max concurrent streams")
+			}
+		})
+	}
+}
+
+func TestGenerator_HTTP2MaxConcurrentStreamsConfig(t *testing.T) {
+	t.Run("env", func(t *testing.T) {
+		cfg := createTestConfig()
+		cfg.HTTP2 = true
+		cfg.EnvSchema = true
+		gen, mfs := createTestGenerator(cfg)
+
+		if err := gen.Generate(); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+
+		config := mfs.FileContent("/output/test-project/internal/config/config.go")
+		if !strings.Contains(config, `cfg.HTTP2MaxConcurrentStreams = getEnvInt("HTTP2_MAX_CONCURRENT_STREAMS", 0)`) {
+			t.Error("config.go should default HTTP2_MAX_CONCURRENT_STREAMS to 0 without --http2-max-concurrent-streams")
+		}
+		if !strings.Contains(config, "func getEnvInt(key string, defaultValue int) int {") {
+			t.Error("config.go should define getEnvInt")
+		}
+		if !strings.Contains(config, `return fmt.Errorf("HTTP2_MAX_CONCURRENT_STREAMS must not be negative")`) {
+			t.Error("config.go should reject a negative HTTP2_MAX_CONCURRENT_STREAMS")
+		}
+		if !strings.Contains(mfs.FileContent("/output/test-project/.env.example"), "\nHTTP2_MAX_CONCURRENT_STREAMS=0\n") {
+			t.Error(".env.example should document HTTP2_MAX_CONCURRENT_STREAMS")
+		}
+		if !strings.Contains(mfs.FileContent("/output/test-project/internal/config/schema.go"), `{Name: "HTTP2_MAX_CONCURRENT_STREAMS", Type: TypeInt},`) {
+			t.Error("the env schema should check HTTP2_MAX_CONCURRENT_STREAMS")
+		}
+	})
+
+	for _, format := range []string{"yaml", "json", "toml", "hcl"} {
+		t.Run(format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = format
+			cfg.HTTP2 = true
+			cfg.MaxConcurrentStreams = 500
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			config := mfs.FileContent("/output/test-project/internal/config/config.go")
+			if !strings.Contains(config, "HTTP2MaxConcurrentStreams int ") {
+				t.Error("AppConfig should hold HTTP2MaxConcurrentStreams")
+			}
+			if !strings.Contains(config, `fmt.Sscanf(maxStreams, "%d", &c.App.HTTP2MaxConcurrentStreams)`) {
+				t.Error("HTTP2_MAX_CONCURRENT_STREAMS should override the config file")
+			}
+			if !strings.Contains(config, `return fmt.Errorf("app.http2_max_concurrent_streams must not be negative")`) {
+				t.Error("config.go should reject a negative stream cap")
+			}
+
+			example := mfs.FileContent("/output/test-project/config." + format + ".example")
+			if !strings.Contains(example, "http2_max_concurrent_streams") || !strings.Contains(example, "500") {
+				t.Errorf("config.%s.example should set http2_max_concurrent_streams", format)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, "h2s := &http2.Server{MaxConcurrentStreams: uint32(cfg.GetHTTP2MaxConcurrentStreams())}") {
+				t.Error("server.go should read the cap through GetHTTP2MaxConcurrentStreams")
+			}
+		})
+	}
+}
+
+func TestGenerator_HTTP2Disabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if strings.Contains(server, "h2c") {
+		t.Error("server.go should not use h2c unless enabled")
+	}
+	if !strings.Contains(server, "Handler:           h,") {
+		t.Error("server.go should serve the middleware chain directly")
+	}
+}
+
+func TestGenerator_ReadHeaderTimeout(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, "ReadHeaderTimeout: 5 * time.Second,") {
+				t.Error("server.go should always set ReadHeaderTimeout")
+			}
+			if strings.Contains(server, "MaxHeaderBytes") {
+				t.Error("server.go should keep the default MaxHeaderBytes unless configured")
+			}
+		})
+	}
+}
+
+func TestGenerator_ServerHeaderLimits(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "echo"
+	cfg.ReadHeaderTimeout = 2500 * time.Millisecond
+	cfg.MaxHeaderBytes = 64 << 10
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	for _, check := range []string{
+		"s.echo.Server.ReadHeaderTimeout = 2500 * time.Millisecond",
+		"s.echo.Server.MaxHeaderBytes = 65536",
+	} {
+		if !strings.Contains(server, check) {
+			t.Errorf("server.go should contain %q", check)
+		}
+	}
+}
+
+func TestGenerator_ServerOptions(t *testing.T) {
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = framework
+			cfg.Databases = []string{"postgres", "mongodb"}
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			for _, want := range []string{
+				"func New(cfg *config.Config, obs *observability.Observability, opts ...Option) (*Server, error) {",
+				"postgresDB atomic.Pointer[database.PostgresDB]",
+				"func (s *Server) Apply(opts ...Option) {",
+				"func WithPostgresDB(db *database.PostgresDB) Option {",
+				"func WithMongoDB(db *database.MongoDB) Option {",
+				"s.postgresDB.Store(db)",
+				"s.mongoDB.Store(db)",
+			} {
+				if !strings.Contains(server, want) {
+					t.Errorf("server.go should contain %q", want)
+				}
+			}
+
+			main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+			if !strings.Contains(main, "srv.Apply(server.WithPostgresDB(postgresDB), server.WithMongoDB(mongoDB))") {
+				t.Error("main.go should hand the connected databases to the server")
+			}
+		})
+	}
+}
+
+func TestGenerator_ServerOptionsWithoutDependencies(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, "type Option func(*Server)") {
+		t.Error("server.go should declare Option even without dependencies")
+	}
+	for _, unused := range []string{"WithPostgresDB", "WithCache", "internal/database", "sync/atomic"} {
+		if strings.Contains(server, unused) {
+			t.Errorf("server.go should not mention %s without dependencies", unused)
+		}
+	}
+	main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	if !strings.Contains(main, "server.New(cfg, obs)") || strings.Contains(main, "srv.Apply(") {
+		t.Error("main.go should create the server without options")
+	}
+}
@@ -0,0 +1,194 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// getLogLevelRef returns the Level of the slog handler options: the LevelVar
+// that CycleLogLevel changes with --signals, the fixed level otherwise.
+func (g *Generator) getLogLevelRef() string {
+	if g.config.Signals {
+		return "logLevel"
+	}
+	return "level"
+}
+
+// getSlogLevelVarSet returns the NewLogger statement setting the initial
+// level of the LevelVar, or "" without --signals.
+func (g *Generator) getSlogLevelVarSet() string {
+	if !g.config.Signals {
+		return ""
+	}
+	return "\tlogLevel.Set(level)\n\n"
+}
+
+// getZapAtomicLevelSet returns the NewZapLogger statements building the logger
+// on the AtomicLevel, or "" without --signals.
+func (g *Generator) getZapAtomicLevelSet() string {
+	if !g.config.Signals {
+		return ""
+	}
+	return `
+	logLevel.SetLevel(zapConfig.Level.Level())
+	zapConfig.Level = logLevel
+`
+}
+
+// getZerologLevel returns the NewZerologLogger statements applying level:
+// the global level that CycleLogLevel changes with --signals, the logger's
+// own level otherwise.
+func (g *Generator) getZerologLevel() (global, own string) {
+	if g.config.Signals {
+		return "\tzerolog.SetGlobalLevel(level)\n\n", ""
+	}
+	return "", "\t\tLevel(level).\n"
+}
+
+// getCycleLogLevelFunc returns CycleLogLevel and the dynamic level it changes
+// for the configured logger, or "" without --signals.
+func (g *Generator) getCycleLogLevelFunc() string {
+	if !g.config.Signals {
+		return ""
+	}
+
+	const doc = `// CycleLogLevel raises the log level to the next of debug, info, warn, and
+// error, wrapping around to debug, and returns the new level.
+`
+	switch g.config.Logger {
+	case "zap":
+		return `
+// logLevel is the level of the loggers built by NewZapLogger.
+var logLevel = zap.NewAtomicLevel()
+
+` + doc + `func CycleLogLevel() zapcore.Level {
+	next := zapcore.DebugLevel
+	switch logLevel.Level() {
+	case zapcore.DebugLevel:
+		next = zapcore.InfoLevel
+	case zapcore.InfoLevel:
+		next = zapcore.WarnLevel
+	case zapcore.WarnLevel:
+		next = zapcore.ErrorLevel
+	}
+	logLevel.SetLevel(next)
+	return next
+}
+`
+	case "zerolog":
+		return `
+` + doc + `func CycleLogLevel() zerolog.Level {
+	next := zerolog.DebugLevel
+	switch zerolog.GlobalLevel() {
+	case zerolog.DebugLevel:
+		next = zerolog.InfoLevel
+	case zerolog.InfoLevel:
+		next = zerolog.WarnLevel
+	case zerolog.WarnLevel:
+		next = zerolog.ErrorLevel
+	}
+	zerolog.SetGlobalLevel(next)
+	return next
+}
+`
+	default:
+		return `
+// logLevel is the level of the loggers built by NewLogger.
+var logLevel = new(slog.LevelVar)
+
+` + doc + `func CycleLogLevel() slog.Level {
+	next := slog.LevelDebug
+	switch logLevel.Level() {
+	case slog.LevelDebug:
+		next = slog.LevelInfo
+	case slog.LevelInfo:
+		next = slog.LevelWarn
+	case slog.LevelWarn:
+		next = slog.LevelError
+	}
+	logLevel.Set(next)
+	return next
+}
+`
+	}
+}
+
+// getLogLevelSignal returns the main.go statements cycling the log level on
+// SIGUSR1, or "" without --signals.
+func (g *Generator) getLogLevelSignal() string {
+	if !g.config.Signals {
+		return ""
+	}
+	return `	// SIGUSR1 cycles the log level without a restart, e.g. kill -USR1 <pid>
+	// (not on Windows, which has no SIGUSR1)
+	cycleLogLevelOnSignal(logger)`
+}
+
+// generateLogLevelSignalFiles writes cmd/<name>/loglevel_signal.go, cycling
+// the log level on SIGUSR1, and its no-op Windows counterpart. Windows has no
+// SIGUSR1, so main.go would not compile there if it referenced the signal.
+func (g *Generator) generateLogLevelSignalFiles() error {
+	if !g.config.Signals {
+		return nil
+	}
+
+	var loggerImport, loggerType, logChange string
+	switch g.config.Logger {
+	case "zap":
+		loggerImport, loggerType = `"go.uber.org/zap"`, "*zap.Logger"
+		logChange = `logger.Sugar().Infow("Log level changed", "log_level", level.String())`
+	case "zerolog":
+		loggerImport, loggerType = `"github.com/rs/zerolog"`, "*zerolog.Logger"
+		logChange = `logger.Info().Stringer("log_level", level).Msg("Log level changed")`
+	default:
+		loggerImport, loggerType = `"log/slog"`, "*slog.Logger"
+		logChange = `logger.Info("Log level changed", "log_level", level.String())`
+	}
+
+	stdImports := []string{`"os"`, `"os/signal"`, `"syscall"`}
+	imports := []string{fmt.Sprintf(`"%s/internal/observability"`, g.config.ModulePath)}
+	if loggerImport == `"log/slog"` {
+		stdImports = append([]string{loggerImport}, stdImports...)
+	} else {
+		imports = append([]string{loggerImport}, imports...)
+	}
+
+	content := fmt.Sprintf(`//go:build !windows
+
+package main
+
+import (
+	%s
+
+	%s
+)
+
+// cycleLogLevelOnSignal cycles the log level through debug, info, warn, and
+// error on every SIGUSR1, logging the new level.
+func cycleLogLevelOnSignal(logger %s) {
+	levelSignals := make(chan os.Signal, 1)
+	signal.Notify(levelSignals, syscall.SIGUSR1)
+	go func() {
+		for range levelSignals {
+			level := observability.CycleLogLevel()
+			%s
+		}
+	}()
+}
+`, strings.Join(stdImports, "\n\t"), strings.Join(imports, "\n\t"), loggerType, logChange)
+	if err := g.writeFile(fmt.Sprintf("cmd/%s/loglevel_signal.go", g.config.ProjectName), content); err != nil {
+		return err
+	}
+
+	windows := fmt.Sprintf(`package main
+
+import (
+	%s
+)
+
+// cycleLogLevelOnSignal does nothing: Windows has no SIGUSR1, so the log
+// level stays the configured one.
+func cycleLogLevelOnSignal(logger %s) {}
+`, loggerImport, loggerType)
+	return g.writeFile(fmt.Sprintf("cmd/%s/loglevel_signal_windows.go", g.config.ProjectName), windows)
+}
@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Signals(t *testing.T) {
+	tests := []struct {
+		logger string
+		level  []string
+	}{
+		{"slog", []string{"var logLevel = new(slog.LevelVar)", "Level: logLevel,", "logLevel.Set(level)"}},
+		{"zap", []string{"var logLevel = zap.NewAtomicLevel()", "zapConfig.Level = logLevel"}},
+		{"zerolog", []string{"zerolog.SetGlobalLevel(level)", "zerolog.SetGlobalLevel(next)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = tt.logger
+			cfg.Signals = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			logger := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+			if !strings.Contains(logger, "func CycleLogLevel()") {
+				t.Error("logger.go should define CycleLogLevel")
+			}
+			for _, want := range tt.level {
+				if !strings.Contains(logger, want) {
+					t.Errorf("logger.go should contain %q", want)
+				}
+			}
+
+			main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+			if !strings.Contains(main, "cycleLogLevelOnSignal(logger)") {
+				t.Error("main.go should cycle the log level on SIGUSR1")
+			}
+			if strings.Contains(main, "syscall.SIGUSR1") {
+				t.Error("main.go should leave SIGUSR1 to a file Windows builds skip")
+			}
+
+			signals := mfs.FileContent("/output/test-project/cmd/test-project/loglevel_signal.go")
+			for _, want := range []string{
+				"//go:build !windows\n\npackage main",
+				"signal.Notify(levelSignals, syscall.SIGUSR1)",
+				"level := observability.CycleLogLevel()",
+			} {
+				if !strings.Contains(signals, want) {
+					t.Errorf("loglevel_signal.go should contain %q", want)
+				}
+			}
+			windows := mfs.FileContent("/output/test-project/cmd/test-project/loglevel_signal_windows.go")
+			if !strings.Contains(windows, "func cycleLogLevelOnSignal(logger ") || strings.Contains(windows, "syscall.SIGUSR1") {
+				t.Error("loglevel_signal_windows.go should define a no-op cycleLogLevelOnSignal")
+			}
+		})
+	}
+}
+
+func TestGenerator_SignalsDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/observability/logger.go"), "CycleLogLevel") {
+		t.Error("logger.go should not define CycleLogLevel unless --signals is set")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/cmd/test-project/main.go"), "cycleLogLevelOnSignal") {
+		t.Error("main.go should not listen for SIGUSR1 unless --signals is set")
+	}
+	if mfs.HasFile("/output/test-project/cmd/test-project/loglevel_signal.go") {
+		t.Error("loglevel_signal.go should only be written with --signals")
+	}
+}
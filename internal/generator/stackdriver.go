@@ -0,0 +1,122 @@
+package generator
+
+// stackdriverLogs reports whether logs use the field names and severities of
+// Google Cloud Logging's structured format, as parsed on GKE and Cloud Run.
+// Correlating entries with traces through logging.googleapis.com/trace is
+// out of scope: the request logs carry no context to read the span from.
+func (g *Generator) stackdriverLogs() bool {
+	return g.config.LogFormat == "stackdriver"
+}
+
+// getSlogHandlerOptions returns the fields of the slog.HandlerOptions literal,
+// renaming the attributes for Cloud Logging with the stackdriver format.
+func (g *Generator) getSlogHandlerOptions() string {
+	if !g.stackdriverLogs() {
+		return "\t\tLevel: " + g.getLogLevelRef() + ",\n"
+	}
+	return "\t\tLevel:       " + g.getLogLevelRef() + ",\n\t\tReplaceAttr: stackdriverAttr,\n"
+}
+
+// getSlogStackdriverFuncs returns the ReplaceAttr function mapping slog's
+// attributes to Cloud Logging's, or "" without the stackdriver format.
+func (g *Generator) getSlogStackdriverFuncs() string {
+	if !g.stackdriverLogs() {
+		return ""
+	}
+	return `
+// stackdriverAttr renames the level and message attributes to the severity
+// and message fields of Cloud Logging, mapping levels to its severities.
+func stackdriverAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+	switch a.Key {
+	case slog.LevelKey:
+		level, _ := a.Value.Any().(slog.Level)
+		return slog.String("severity", stackdriverSeverity(level))
+	case slog.MessageKey:
+		a.Key = "message"
+	}
+	return a
+}
+
+// stackdriverSeverity returns the Cloud Logging severity of level.
+func stackdriverSeverity(level slog.Level) string {
+	switch {
+	case level >= slog.LevelError:
+		return "ERROR"
+	case level >= slog.LevelWarn:
+		return "WARNING"
+	case level >= slog.LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+`
+}
+
+// getZapDevelopmentEncoding returns the development branch statement
+// colouring levels, which the stackdriver format's JSON encoder replaces.
+func (g *Generator) getZapDevelopmentEncoding() string {
+	if g.stackdriverLogs() {
+		return ""
+	}
+	return "\t\tzapConfig.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder\n"
+}
+
+// getZapStackdriverConfig returns the NewZapLogger statements switching to
+// Cloud Logging's field names, or "" without the stackdriver format.
+func (g *Generator) getZapStackdriverConfig() string {
+	if !g.stackdriverLogs() {
+		return ""
+	}
+	return `
+	// Cloud Logging parses JSON lines with its own field names
+	zapConfig.Encoding = "json"
+	zapConfig.EncoderConfig = stackdriverEncoderConfig()
+`
+}
+
+// getZapStackdriverFuncs returns the encoder config of Cloud Logging's
+// structured format, or "" without the stackdriver format.
+func (g *Generator) getZapStackdriverFuncs() string {
+	if !g.stackdriverLogs() {
+		return ""
+	}
+	return `
+// stackdriverEncoderConfig returns a JSON encoder config using the severity,
+// message, and time fields of Cloud Logging.
+func stackdriverEncoderConfig() zapcore.EncoderConfig {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.LevelKey = "severity"
+	encoderConfig.MessageKey = "message"
+	encoderConfig.TimeKey = "time"
+	encoderConfig.EncodeLevel = stackdriverLevelEncoder
+	encoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	return encoderConfig
+}
+
+// stackdriverLevelEncoder encodes level as its Cloud Logging severity.
+func stackdriverLevelEncoder(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+	switch level {
+	case zapcore.DebugLevel:
+		enc.AppendString("DEBUG")
+	case zapcore.InfoLevel:
+		enc.AppendString("INFO")
+	case zapcore.WarnLevel:
+		enc.AppendString("WARNING")
+	case zapcore.ErrorLevel:
+		enc.AppendString("ERROR")
+	case zapcore.DPanicLevel:
+		enc.AppendString("CRITICAL")
+	case zapcore.PanicLevel:
+		enc.AppendString("ALERT")
+	case zapcore.FatalLevel:
+		enc.AppendString("EMERGENCY")
+	default:
+		enc.AppendString("DEFAULT")
+	}
+}
+`
+}
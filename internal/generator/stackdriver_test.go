@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_StackdriverLogFormat(t *testing.T) {
+	tests := []struct {
+		logger string
+		checks []string
+	}{
+		{"slog", []string{
+			"ReplaceAttr: stackdriverAttr,",
+			`return slog.String("severity", stackdriverSeverity(level))`,
+			`a.Key = "message"`,
+		}},
+		{"zap", []string{
+			"zapConfig.EncoderConfig = stackdriverEncoderConfig()",
+			`encoderConfig.LevelKey = "severity"`,
+			`enc.AppendString("WARNING")`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = tt.logger
+			cfg.LogFormat = "stackdriver"
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			logger := mfs.FileContent("/output/test-project/internal/observability/logger.go")
+			for _, check := range tt.checks {
+				if !strings.Contains(logger, check) {
+					t.Errorf("logger.go should contain %q", check)
+				}
+			}
+			// Nothing writes the trace field, so it isn't declared either
+			if strings.Contains(logger, "logging.googleapis.com/trace") {
+				t.Error("logger.go should not declare the unused trace field")
+			}
+		})
+	}
+}
+
+func TestGenerator_StackdriverLogFormatDisabled(t *testing.T) {
+	for _, logger := range []string{"slog", "zap"} {
+		t.Run(logger, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Logger = logger
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			if strings.Contains(mfs.FileContent("/output/test-project/internal/observability/logger.go"), "severity") {
+				t.Error("logger.go should keep the default field names unless the stackdriver format is selected")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// startupDependency is a database or cache main.go connects to before the
+// service reports ready.
+type startupDependency struct {
+	name    string // Human readable name used in the log message
+	varName string
+	pkg     string // Package under internal/, e.g. database
+	newFunc string
+	close   string // Deferred close call on varName
+}
+
+// getStartupDependencies returns the configured databases and caches in the
+// order main.go connects to them.
+func (g *Generator) getStartupDependencies() []startupDependency {
+	var deps []startupDependency
+	if g.config.HasDatabase("postgres") {
+		deps = append(deps, startupDependency{"PostgreSQL", "postgresDB", "database", "NewPostgresDB", "Close()"})
+	}
+	if g.config.HasDatabase("mysql") {
+		deps = append(deps, startupDependency{"MySQL", "mysqlDB", "database", "NewMySQLDB", "Close()"})
+	}
+	if g.config.HasDatabase("mongodb") {
+		deps = append(deps, startupDependency{"MongoDB", "mongoDB", "database", "NewMongoDB", "Close(context.Background())"})
+	}
+	if g.config.HasDatabase("redis") {
+		deps = append(deps, startupDependency{"Redis", "redisCache", "cache", "NewRedisCache", "Close()"})
+	}
+	return deps
+}
+
+// getMainInternalImports returns the internal packages imported by main.go,
+// sorted as gofmt would leave them.
+func (g *Generator) getMainInternalImports() []string {
+	pkgs := []string{"config", "observability", "server"}
+	for _, dep := range g.getStartupDependencies() {
+		if !slices.Contains(pkgs, dep.pkg) {
+			pkgs = append(pkgs, dep.pkg)
+		}
+	}
+	slices.Sort(pkgs)
+
+	imports := make([]string, len(pkgs))
+	for i, pkg := range pkgs {
+		imports[i] = g.config.ModulePath + "/internal/" + pkg
+	}
+	return imports
+}
+
+// getDependencyInit returns the main.go statements connecting to every
+// dependency, returning exitDependencyError when one is unreachable, or ""
+// without dependencies.
+func (g *Generator) getDependencyInit() string {
+	var blocks []string
+	for _, dep := range g.getStartupDependencies() {
+		blocks = append(blocks, fmt.Sprintf(`	%[1]s, err := %[2]s.%[3]s(ctx, cfg)
+	if err != nil {
+		logger.Error("Failed to connect to %[4]s", "error", err)
+		return exitDependencyError
+	}
+	defer %[1]s.%[5]s`, dep.varName, dep.pkg, dep.newFunc, dep.name, dep.close))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// typeName returns the qualified type of the dependency, e.g. *database.PostgresDB.
+func (d startupDependency) typeName() string {
+	return "*" + d.pkg + "." + strings.TrimPrefix(d.newFunc, "New")
+}
+
+// optionName returns the server option handing over the dependency, e.g.
+// WithPostgresDB. The cache keeps the shorter WithCache.
+func (d startupDependency) optionName() string {
+	if d.pkg == "cache" {
+		return "WithCache"
+	}
+	return "With" + strings.TrimPrefix(d.newFunc, "New")
+}
+
+// getServerOptions returns the options main.go applies once every dependency
+// is connected, or "" without dependencies.
+func (g *Generator) getServerOptions() string {
+	var opts []string
+	for _, dep := range g.getStartupDependencies() {
+		opts = append(opts, fmt.Sprintf("server.%s(%s)", dep.optionName(), dep.varName))
+	}
+	return strings.Join(opts, ", ")
+}
+
+// getServerDependencyFields returns the Server fields holding the databases
+// set by the options, or "" without databases. They are atomic because
+// main.go applies the options while the server is already serving.
+func (g *Generator) getServerDependencyFields() string {
+	var databases []startupDependency
+	for _, dep := range g.getStartupDependencies() {
+		if dep.pkg == "database" {
+			databases = append(databases, dep)
+		}
+	}
+	if len(databases) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, dep := range databases {
+		width = max(width, len(dep.varName))
+	}
+	var sb strings.Builder
+	sb.WriteString("\n\t// Databases handed over by the options, possibly after Start\n")
+	for _, dep := range databases {
+		fmt.Fprintf(&sb, "\t%-*s atomic.Pointer[%s]\n", width, dep.varName, strings.TrimPrefix(dep.typeName(), "*"))
+	}
+	return sb.String()
+}
+
+// getServerOptionFuncs returns the Option type of the server package, Apply,
+// and one option per configured dependency.
+func (g *Generator) getServerOptionFuncs() string {
+	var sb strings.Builder
+	sb.WriteString(`
+// Option configures a Server, e.g. handing it a connected dependency.
+type Option func(*Server)
+
+// Apply applies opts to the server. Options store through atomics, so
+// main.go can hand over dependencies it connects after Start.
+func (s *Server) Apply(opts ...Option) {
+	for _, opt := range opts {
+		opt(s)
+	}
+}
+`)
+
+	for _, dep := range g.getStartupDependencies() {
+		if dep.pkg == "cache" {
+			sb.WriteString(`
+// WithCache hands the connected cache to the handlers serving /cached.
+func WithCache(c *cache.RedisCache) Option {
+	return func(s *Server) {
+		s.handler.SetCache(c)
+	}
+}
+`)
+			continue
+		}
+		fmt.Fprintf(&sb, `
+// %[1]s hands the connected %[2]s database to the server, for handlers
+// and health checks that need it.
+func %[1]s(db %[3]s) Option {
+	return func(s *Server) {
+		s.%[4]s.Store(db)
+	}
+}
+`, dep.optionName(), dep.name, dep.typeName(), dep.varName)
+	}
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_StartupReadinessGating(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres", "redis"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	if strings.Contains(handlers, "h.ready.Store(true)") {
+		t.Error("NewHandler should leave readiness false until startup succeeded")
+	}
+
+	main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	for _, check := range []string{
+		`"github.com/test/test-project/internal/cache"`,
+		`"github.com/test/test-project/internal/database"`,
+		"postgresDB, err := database.NewPostgresDB(ctx, cfg)",
+		"redisCache, err := cache.NewRedisCache(ctx, cfg)",
+		"return exitDependencyError",
+	} {
+		if !strings.Contains(main, check) {
+			t.Errorf("main.go should contain %q", check)
+		}
+	}
+
+	// The server answers /health while the dependencies connect, so they are
+	// connected after Start and handed over before readiness turns true
+	start := strings.Index(main, "srv.Start()")
+	ready := strings.Index(main, "srv.SetReady(true)")
+	if start < 0 || ready < 0 {
+		t.Fatal("main.go should start the server and set readiness once startup succeeded")
+	}
+	for _, init := range []string{
+		"database.NewPostgresDB(",
+		"cache.NewRedisCache(",
+		"srv.Apply(server.WithPostgresDB(postgresDB), server.WithCache(redisCache))",
+	} {
+		if i := strings.Index(main, init); i < start || i > ready {
+			t.Errorf("main.go should call %s between starting the server and setting readiness", init)
+		}
+	}
+}
+
+func TestGenerator_StartupWithoutDependencies(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	main := mfs.FileContent("/output/test-project/cmd/test-project/main.go")
+	if !strings.Contains(main, "srv.SetReady(true)") {
+		t.Error("main.go should set readiness once the server is started")
+	}
+	for _, unused := range []string{"exitDependencyError", "internal/database", "internal/cache"} {
+		if strings.Contains(main, unused) {
+			t.Errorf("main.go should not mention %s without dependencies", unused)
+		}
+	}
+}
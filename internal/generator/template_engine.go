@@ -39,17 +39,43 @@ type TemplateData struct {
 
 // ServerTemplateData holds data for server templates.
 type ServerTemplateData struct {
-	ModulePath    string
-	PortRef       string
-	EnvRef        string
-	EnableTracing bool
-	EnableMetrics bool
+	ModulePath     string
+	PortRef        string
+	EnvRef         string
+	EnableTracing  bool
+	EnableMetrics  bool
+	SlowRequestRef string
+	Routes         []string // Route registrations from g.routes()
+	CORSPolicy     string   // NewCORSPolicy call, empty when CORS is disabled
+	MaxInflight    string   // Config reference of the in-flight request cap, empty without the limiter
+	RouteTimeout   string   // RouteTimeout call with the per-route timeouts, empty without them
+	TraceIDHeader  string   // Quoted response header echoing the trace ID, empty without it
+	NeedsCache     bool     // Import the cache package and hand the cache to the handlers
+	NeedsDatabase  bool     // Import the database package for the database options
+
+	DependencyFields string // Server fields set by the options, empty without dependencies
+	OptionFuncs      string // Option type and the With* options of the dependencies
+
+	ExportMiddleware bool // Use the pkg/httpmw Chain, Recoverer, and RequestID
+	DisableRecover   bool // Recover panics only in production, letting them propagate elsewhere
+	HTTP2            bool // Wrap the handler with h2c and configure http2.Server
+	EnableWebSocket  bool // Keep /ws out of middleware that can't hijack connections
+
+	TrustedProxies string // []string literal of trusted proxy CIDRs, empty when none
+
+	ReadHeaderTimeout string // Duration literal, always set to mitigate Slowloris
+	MaxHeaderBytes    int    // 0 keeps the net/http default
+
+	MaxConcurrentStreams string // Config reference of the HTTP/2 streams per connection cap, empty without HTTP/2
 }
 
 // DockerTemplateData holds data for Docker templates.
 type DockerTemplateData struct {
 	ProjectName string
 	GoVersion   string
+	Port        int
+	PortEnv     string
+	WaitForIt   bool
 }
 
 // MakefileTemplateData holds data for Makefile templates.
@@ -57,6 +83,16 @@ type MakefileTemplateData struct {
 	ProjectName   string
 	GoVersion     string
 	IncludeDocker bool
+	CIConfig      string
+	Tools         string // Command packages installed by make tools
+	Port          int    // Host port published by make run-docker
+	ContainerPort int    // Port the app listens on inside the container
+	PortEnv       string // Name of the PORT environment variable
+	GoPrivate     string // GOPRIVATE pattern exported by make deps, empty without --private
+	VulnCheck     bool   // Run make vuln as part of make ci, as CI does
+	SBOM          bool   // Generate make sbom
+	ConfigExample string // Config file example make config-diff compares against, empty for env config
+	PprofAddrEnv  string // Env var of the pprof address of make build-debug binaries, empty without --build-tags
 }
 
 // NewTemplateData creates TemplateData from a config.
@@ -110,6 +146,27 @@ func (g *Generator) getLoggerImport() string {
 	}
 }
 
+// delimsDirective starts an optional first template line setting custom
+// action delimiters, e.g. "go-template-sh:delims [[ ]]", for templates whose
+// output contains literal {{ }} such as GitHub Actions expressions or Helm
+// charts. The directive line is not part of the output.
+const delimsDirective = "go-template-sh:delims"
+
+// parseDelims strips a delims directive from tmplText, returning the
+// remaining text and its delimiters, or empty delimiters for the default.
+func parseDelims(name, tmplText string) (text, left, right string, err error) {
+	first, rest, _ := strings.Cut(tmplText, "\n")
+	directive, ok := strings.CutPrefix(strings.TrimSpace(first), delimsDirective)
+	if !ok {
+		return tmplText, "", "", nil
+	}
+	delims := strings.Fields(directive)
+	if len(delims) != 2 {
+		return "", "", "", fmt.Errorf("template %s: %s needs a left and a right delimiter", name, delimsDirective)
+	}
+	return rest, delims[0], delims[1], nil
+}
+
 // executeTemplate parses and executes a template with the given data.
 func executeTemplate(name, tmplText string, data any) (string, error) {
 	funcMap := template.FuncMap{
@@ -132,7 +189,12 @@ func executeTemplate(name, tmplText string, data any) (string, error) {
 		"trimPrefix": strings.TrimPrefix,
 	}
 
-	tmpl, err := template.New(name).Funcs(funcMap).Parse(tmplText)
+	tmplText, left, right, err := parseDelims(name, tmplText)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New(name).Delims(left, right).Funcs(funcMap).Parse(tmplText)
 	if err != nil {
 		return "", err
 	}
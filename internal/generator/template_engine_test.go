@@ -57,6 +57,18 @@ func TestExecuteTemplate(t *testing.T) {
 			},
 			want: "value",
 		},
+		{
+			name:     "custom delimiters keep literal braces",
+			template: "go-template-sh:delims [[ ]]\nrun: ${{ secrets.TOKEN }} for [[.Name]]",
+			data:     struct{ Name string }{"api"},
+			want:     "run: ${{ secrets.TOKEN }} for api",
+		},
+		{
+			name:     "invalid delimiter directive",
+			template: "go-template-sh:delims [[\n[[.Name]]",
+			data:     nil,
+			wantErr:  true,
+		},
 		{
 			name:     "invalid template syntax",
 			template: "{{.Name",
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -17,10 +18,30 @@ func (g *Generator) generateTestFiles() error {
 		}
 	}
 
+	if err := g.generateConfigTests(); err != nil {
+		return err
+	}
+
+	if err := g.generateEnvSchemaTests(); err != nil {
+		return err
+	}
+
+	if err := g.generateMiddlewareTests(); err != nil {
+		return err
+	}
+
 	if err := g.generateTestSuiteExample(); err != nil {
 		return err
 	}
 
+	if err := g.generateCloseTests(); err != nil {
+		return err
+	}
+
+	if err := g.generateObservabilityTests(); err != nil {
+		return err
+	}
+
 	return g.generateTestingReadme()
 }
 
@@ -86,7 +107,18 @@ func (suite *HandlerTestSuite) TestHealth() {
 	suite.Equal("Service is healthy", response.Message)
 }
 
+func (suite *HandlerTestSuite) TestReadyBeforeStartup() {
+	req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.Ready(w, req)
+
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+}
+
 func (suite *HandlerTestSuite) TestReady() {
+	suite.handler.SetReady(true)
+
 	req := httptest.NewRequest(http.MethodGet, "/ready", nil)
 	w := httptest.NewRecorder()
 	
@@ -100,6 +132,18 @@ func (suite *HandlerTestSuite) TestReady() {
 	suite.Equal("ready", response.Status)
 }
 
+func (suite *HandlerTestSuite) TestReadyDuringDrain() {
+	suite.handler.SetReady(true)
+	suite.handler.SetReady(false)
+
+	req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+	w := httptest.NewRecorder()
+	
+	suite.handler.Ready(w, req)
+	
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+}
+
 func (suite *HandlerTestSuite) TestIndex() {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	w := httptest.NewRecorder()
@@ -114,7 +158,36 @@ func (suite *HandlerTestSuite) TestIndex() {
 	suite.Equal("ok", response.Status)
 	suite.NotNil(response.Data)
 	suite.Equal("1.0.0", response.Data["version"])
-	suite.Equal(%[4]s, response.Data["environment"])
+	suite.Equal("test", response.Data["environment"])
+}
+
+// TestIndexEnvironment checks that Index reports the environment of the
+// config the handler was created with.
+func TestIndexEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+	}{
+		{name: "development", environment: "development"},
+		{name: "staging", environment: "staging"},
+		{name: "production", environment: "production"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{%[4]s}
+			handler := NewHandler(cfg, &observability.Observability{})
+
+			w := httptest.NewRecorder()
+			handler.Index(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			var response Response
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, "1.0.0", response.Data["version"])
+			assert.Equal(t, tt.environment, response.Data["environment"])
+		})
+	}
 }
 
 %[5]s
@@ -166,8 +239,8 @@ func TestResponseJSONMarshaling(t *testing.T) {
 		g.config.ModulePath,
 		g.getTestImports(),
 		g.getTestConfigFields(),
-		g.getConfigFieldReference("Environment"),
-		g.getFrameworkSpecificTests(),
+		g.getTestEnvironmentConfig(),
+		g.getFrameworkSpecificTests()+g.getCachedHandlerTests()+g.getRespondHandlerTests(),
 	)
 }
 
@@ -179,21 +252,47 @@ func (g *Generator) getTestImports() string {
 		return `	"github.com/labstack/echo/v4"`
 	case "fiber":
 		return `	"github.com/gofiber/fiber/v2"`
+	case "fasthttp":
+		return `	"github.com/valyala/fasthttp"`
 	default:
 		return ""
 	}
 }
 
+// getTestConfigFields returns the fields of the generated tests' config. With
+// tracing it sets an OTLP endpoint, without which observability.New fails; the
+// exporter connects lazily, so no collector needs to listen there.
 func (g *Generator) getTestConfigFields() string {
 	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
+		if g.config.EnableTracing {
+			return `		Environment:  "test",
+		Port:         "8080",
+		OTLPEndpoint: "localhost:4317",`
+		}
 		return `		Environment: "test",
 		Port:        "8080",`
 	}
 
-	return `		App: config.AppConfig{
+	fields := `		App: config.AppConfig{
 			Environment: "test",
 			Port:        8080,
 		},`
+	if g.config.EnableTracing {
+		fields += `
+		Observability: config.ObservabilityConfig{
+			Tracing: config.TracingConfig{OTLPEndpoint: "localhost:4317"},
+		},`
+	}
+	return fields
+}
+
+// getTestEnvironmentConfig returns the fields of a test config whose
+// environment is tt.environment.
+func (g *Generator) getTestEnvironmentConfig() string {
+	if g.config.ConfigFormat == "" || g.config.ConfigFormat == "env" {
+		return `Environment: tt.environment, Port: "8080"`
+	}
+	return `App: config.AppConfig{Environment: tt.environment, Port: 8080}`
 }
 
 func (g *Generator) getFrameworkSpecificTests() string {
@@ -235,12 +334,333 @@ func (suite *HandlerTestSuite) TestHealthFiber() {
 	resp, err := app.Test(req)
 	suite.NoError(err)
 	suite.Equal(http.StatusOK, resp.StatusCode)
+}`
+	case "fasthttp":
+		return `
+func (suite *HandlerTestSuite) TestHealthFastHTTP() {
+	var ctx fasthttp.RequestCtx
+	ctx.Request.SetRequestURI("/health")
+	
+	suite.handler.HealthFastHTTP(&ctx)
+	
+	suite.Equal(fasthttp.StatusOK, ctx.Response.StatusCode())
+	
+	var response Response
+	err := json.Unmarshal(ctx.Response.Body(), &response)
+	suite.NoError(err)
+	suite.Equal("ok", response.Status)
 }`
 	default:
 		return ""
 	}
 }
 
+// generateConfigTests writes the tests of config.Load. Structured formats
+// load a copy of the config example from testdata, so the example users
+// start from is checked to load as well.
+func (g *Generator) generateConfigTests() error {
+	structured := g.config.ConfigFormat != "" && g.config.ConfigFormat != "env"
+	if structured {
+		var example string
+		switch g.config.ConfigFormat {
+		case "yaml":
+			example = g.getYAMLConfigExample()
+		case "json":
+			example = g.getJSONConfigExample()
+		case "toml":
+			example = g.getTOMLConfigExample()
+		case "hcl":
+			example = g.getHCLConfigExample()
+		}
+		if err := g.writeFile("internal/config/testdata/config."+g.config.ConfigFormat, example); err != nil {
+			return err
+		}
+	}
+
+	imports := `"testing"
+	"time"`
+	keys := []string{g.envVar("ENVIRONMENT"), g.envVar("PORT"), g.envVar("DRAIN_DELAY"), g.envVar("SLOW_REQUEST_THRESHOLD")}
+	loadDefaults := "\tclearConfigEnv(t)\n"
+	var fileTests string
+	if structured {
+		imports = `"path/filepath"
+	"testing"
+	"time"`
+		keys = append(keys, g.envVar("CONFIG_PATH"))
+		loadDefaults += fmt.Sprintf("\tt.Setenv(%q, filepath.Join(\"testdata\", \"config.%s\"))\n", g.envVar("CONFIG_PATH"), g.config.ConfigFormat)
+		fileTests = fmt.Sprintf(`
+func TestLoad_WithoutConfigFile(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(%[1]q, filepath.Join(t.TempDir(), "missing.%[2]s"))
+	t.Setenv(%[3]q, "9090")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, "9090", %[4]s)
+	assert.Equal(t, "development", %[5]s)
+}
+`, g.envVar("CONFIG_PATH"), g.config.ConfigFormat, g.envVar("PORT"), g.getConfigFieldReference("Port"), g.getConfigFieldReference("Environment"))
+	}
+
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = fmt.Sprintf("%q", key)
+	}
+
+	content := fmt.Sprintf(`package config
+
+import (
+	%[1]s
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearConfigEnv unsets the variables Load reads for the duration of the
+// test, so that the environment of the test run does not leak into it.
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{%[2]s} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+%[3]s
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, "%[4]d", %[5]s)
+	assert.Equal(t, "development", %[6]s)
+}
+
+func TestLoad_EnvOverrides(t *testing.T) {
+%[3]s%[12]s	t.Setenv(%[7]q, "staging")
+	t.Setenv(%[8]q, "9090")
+	t.Setenv(%[9]q, "5s")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+
+	assert.Equal(t, "9090", %[5]s)
+	assert.Equal(t, "staging", %[6]s)
+	assert.Equal(t, 5*time.Second, %[10]s)
+}
+%[11]s
+func TestValidate_RequiresPort(t *testing.T) {
+	cfg := &Config{}
+	assert.Error(t, cfg.validate())
+}
+`, imports, strings.Join(quoted, ", "), loadDefaults, g.config.AppPort(),
+		g.getConfigFieldReference("Port"), g.getConfigFieldReference("Environment"),
+		g.envVar("ENVIRONMENT"), g.envVar("PORT"), g.envVar("DRAIN_DELAY"), g.getConfigFieldReference("DrainDelay"), fileTests,
+		g.getEnvSchemaRequiredSetenv())
+
+	return g.writeFile("internal/config/config_test.go", content)
+}
+
+// generateMiddlewareTests writes tests pinning the middleware order of the
+// net/http server, the only one wiring its middleware with Chain. Chain and
+// its middleware are tested in pkg/httpmw when exported there.
+func (g *Generator) generateMiddlewareTests() error {
+	if g.framework().ServerTemplate() != "server_stdlib.go.tmpl" {
+		return nil
+	}
+	if g.config.ExportMiddleware {
+		if err := g.generateHTTPMWTests(); err != nil {
+			return err
+		}
+	}
+
+	stdImports := []string{`"net/http"`, `"net/http/httptest"`, `"testing"`}
+	imports := []string{`"github.com/stretchr/testify/assert"`}
+	var loggerInit string
+	// Only the Chain and CORS tests build loggers
+	needsLogger := !g.config.ExportMiddleware || g.config.EnableCORS
+	switch g.config.Logger {
+	case "zap":
+		loggerInit = "zap.NewNop()"
+		if needsLogger {
+			imports = append(imports, `"go.uber.org/zap"`)
+		}
+	case "zerolog":
+		loggerInit = "func() *zerolog.Logger { l := zerolog.Nop(); return &l }()"
+		if needsLogger {
+			imports = append(imports, `"github.com/rs/zerolog"`)
+		}
+	default:
+		loggerInit = "slog.New(slog.NewTextHandler(io.Discard, nil))"
+		if needsLogger {
+			stdImports = append([]string{`"io"`, `"log/slog"`}, stdImports...)
+		}
+	}
+
+	if g.config.TraceIDHeader != "" && g.config.EnableTracing {
+		imports = append(imports, `"go.opentelemetry.io/otel/trace"`)
+	}
+	if g.config.ExportMiddleware && (g.config.TraceIDHeader != "" || g.config.EnableMetrics) {
+		imports = append(imports, fmt.Sprintf(`"%s/pkg/httpmw"`, g.config.ModulePath))
+	}
+
+	if len(g.config.RouteTimeouts) > 0 {
+		stdImports = append(stdImports, `"io"`, `"time"`)
+		imports = append(imports, `"github.com/stretchr/testify/require"`)
+	}
+
+	if g.config.EnableMetrics {
+		stdImports = append(stdImports, `"context"`, `"strconv"`, `"strings"`, `"sync"`)
+		imports = append(imports,
+			`"github.com/stretchr/testify/require"`,
+			fmt.Sprintf(`"%s/internal/config"`, g.config.ModulePath),
+			fmt.Sprintf(`"%s/internal/observability"`, g.config.ModulePath),
+		)
+	}
+	slices.Sort(stdImports)
+	slices.Sort(imports)
+	stdImports = slices.Compact(stdImports)
+	imports = slices.Compact(imports)
+
+	tests := g.getCORSMiddlewareTests(loggerInit) + g.getMaxInflightMiddlewareTests() + g.getRouteTimeoutMiddlewareTests() + g.getTraceIDMiddlewareTests() + g.getRecovererTests(loggerInit) + g.getPanicMetricsTests(loggerInit)
+	if !g.config.ExportMiddleware {
+		recovererArgs := g.getRecovererTestObs()
+		if g.config.DisableRecover {
+			recovererArgs = `, "production"` + recovererArgs
+		}
+		tests = fmt.Sprintf(`
+func TestChain_FirstMiddlewareIsOutermost(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	h := Chain(http.NotFoundHandler(), record("first"), record("second"))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, []string{"first", "second"}, order)
+}
+
+func TestChain_RecoversPanicWithRequestID(t *testing.T) {
+	logger := %s
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	// Same order as the server: Recoverer outermost, then RequestID
+	h := Chain(panicking,
+		func(next http.Handler) http.Handler { return Recoverer(next, logger%s) },
+		RequestID,
+		func(next http.Handler) http.Handler { return Logger(next, logger, 0) },
+	)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotEmpty(t, w.Header().Get("X-Request-ID"))
+}
+`, loggerInit, recovererArgs) + tests
+	}
+	if tests == "" {
+		return nil
+	}
+
+	content := fmt.Sprintf(`package middleware
+
+import (
+	%s
+
+	%s
+)
+%s`, strings.Join(stdImports, "\n\t"), strings.Join(imports, "\n\t"), tests)
+
+	return g.writeFile("internal/middleware/middleware_test.go", content)
+}
+
+// generateHTTPMWTests writes the tests of the exported pkg/httpmw package,
+// pinning the server's middleware order.
+func (g *Generator) generateHTTPMWTests() error {
+	content := `package httpmw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain_FirstMiddlewareIsOutermost(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	h := Chain(http.NotFoundHandler(), record("first"), record("second"))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, []string{"first", "second"}, order)
+}
+
+func TestChain_RecoversPanicWithRequestID(t *testing.T) {
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	// Same order as the server: Recoverer outermost, then RequestID
+	h := Chain(panicking, Recoverer, RequestID)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotEmpty(t, w.Header().Get("X-Request-ID"))
+}
+
+func TestRecovererWith_CallsOnPanic(t *testing.T) {
+	var panics int
+	h := RecovererWith(func() { panics++ })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, 1, panics)
+}
+
+func TestLogger_ReportsStatusAndRequestID(t *testing.T) {
+	var status int
+	var requestID string
+	log := func(r *http.Request, s, bytes int, duration time.Duration) {
+		status = s
+		requestID = RequestIDFromContext(r.Context())
+	}
+	h := Chain(http.NotFoundHandler(), RequestID, func(next http.Handler) http.Handler { return Logger(next, log) })
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-ID", "abc")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "abc", requestID)
+}
+`
+	return g.writeFile("pkg/httpmw/httpmw_test.go", content)
+}
+
 func (g *Generator) generateMockInterfaces() error {
 	content := g.getMockInterfacesContent()
 	if content == "" {
@@ -375,6 +795,147 @@ func (suite *DatabaseTestSuite) TestQueryExecution() {
 `, mockImport, mockSetup)
 }
 
+// generateObservabilityTests writes the tests of the observability wiring:
+// New builds a logger, MetricsHandler serves the recorded metrics, and
+// Shutdown can be called more than once.
+func (g *Generator) generateObservabilityTests() error {
+	stdImports := []string{`"context"`}
+	metricsTest := ""
+	if g.config.EnableMetrics {
+		stdImports = append(stdImports, `"net/http"`, `"net/http/httptest"`)
+		metricsTest = `
+
+	t.Run("MetricsHandler", func(t *testing.T) {
+		obs.RecordRequest(http.MethodGet, "/health", http.StatusOK, 42, 10*time.Millisecond)
+
+		w := httptest.NewRecorder()
+		obs.MetricsHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "http_requests_total")
+	})`
+	}
+	stdImports = append(stdImports, `"testing"`, `"time"`)
+	imports := []string{
+		fmt.Sprintf(`"%s/internal/config"`, g.config.ModulePath),
+		`"github.com/stretchr/testify/assert"`,
+		`"github.com/stretchr/testify/require"`,
+	}
+	slices.Sort(imports)
+
+	content := fmt.Sprintf(`package observability
+
+import (
+	%s
+
+	%s
+)
+
+// TestObservability shares one New across its subtests: New registers the
+// metrics with the default Prometheus registry, which rejects duplicates.
+func TestObservability(t *testing.T) {
+	cfg := &config.Config{
+%s
+	}
+
+	obs, err := New(context.Background(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, obs)
+
+	t.Run("Logger", func(t *testing.T) {
+		assert.NotNil(t, obs.Logger)
+	})%s
+
+	t.Run("Shutdown", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		assert.NoError(t, obs.Shutdown(ctx))
+		assert.NoError(t, obs.Shutdown(ctx), "a second Shutdown should be a no-op")
+	})
+}
+
+func TestShutdown_ZeroValue(t *testing.T) {
+	obs := &Observability{}
+
+	assert.NoError(t, obs.Shutdown(context.Background()))
+}
+`, strings.Join(stdImports, "\n\t"), strings.Join(imports, "\n\t"), g.getTestConfigFields(), metricsTest)
+
+	return g.writeFile("internal/observability/observability_test.go", content)
+}
+
+// generateCloseTests writes tests checking that the PostgresDB and RedisCache
+// Close methods are safe on a zero value and when called twice. Neither needs
+// a running server, as the pool and client connect lazily.
+func (g *Generator) generateCloseTests() error {
+	if g.config.HasDatabase("postgres") {
+		content := `package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDB_CloseWithoutPool(t *testing.T) {
+	db := &PostgresDB{}
+
+	assert.NotPanics(t, db.Close)
+}
+
+func TestPostgresDB_CloseIsIdempotent(t *testing.T) {
+	pool, err := pgxpool.New(context.Background(), "postgres://localhost:5432/test")
+	require.NoError(t, err)
+	db := &PostgresDB{pool: pool}
+
+	assert.NotPanics(t, func() {
+		db.Close()
+		db.Close()
+	})
+}
+`
+		if err := g.writeFile("internal/database/postgres_close_test.go", content); err != nil {
+			return err
+		}
+	}
+
+	if g.config.HasDatabase("redis") {
+		content := `package cache
+
+import (
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisCache_CloseWithoutClient(t *testing.T) {
+	c := &RedisCache{}
+
+	assert.NoError(t, c.Close())
+}
+
+func TestRedisCache_CloseTwice(t *testing.T) {
+	c := &RedisCache{client: redis.NewClient(&redis.Options{Addr: "localhost:6379"})}
+
+	require.NoError(t, c.Close())
+	// go-redis reports the second Close as redis.ErrClosed
+	assert.NotPanics(t, func() { _ = c.Close() })
+}
+`
+		if err := g.writeFile("internal/cache/redis_close_test.go", content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (g *Generator) generateTestingReadme() error {
 	content := g.getTestingReadmeContent()
 	return g.writeFile("docs/TESTING.md", content)
@@ -419,6 +980,12 @@ Example in `+"`internal/handlers/handlers_test.go`"+`:
 - Tests all HTTP handlers
 - Demonstrates table-driven tests
 
+### Config Tests
+Example in `+"`internal/config/config_test.go`"+`:
+- Tests `+"`config.Load()`"+` defaults and environment overrides
+- Loads `+"`internal/config/testdata`"+`, a copy of the config example, for file-based configs
+- Checks that validation rejects a missing port
+
 ### Database Tests
 Example in `+"`internal/database/database_test.go`"+`:
 - Uses uber-go/mock for database mocking
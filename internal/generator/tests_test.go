@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ConfigTests(t *testing.T) {
+	tests := []struct {
+		format  string
+		fixture string
+		port    string
+	}{
+		{"env", "", `assert.Equal(t, "8080", cfg.Port)`},
+		{"yaml", "config.yaml.example", `assert.Equal(t, "8080", cfg.GetPort())`},
+		{"json", "config.json.example", `assert.Equal(t, "8080", cfg.GetPort())`},
+		{"toml", "config.toml.example", `assert.Equal(t, "8080", cfg.GetPort())`},
+		{"hcl", "config.hcl.example", `assert.Equal(t, "8080", cfg.GetPort())`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = tt.format
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			if !mfs.HasFile("/output/test-project/internal/config/config_test.go") {
+				t.Fatal("internal/config/config_test.go should be generated")
+			}
+			content := mfs.FileContent("/output/test-project/internal/config/config_test.go")
+			for _, want := range []string{"func TestLoad_Defaults(t *testing.T)", "func TestLoad_EnvOverrides(t *testing.T)", tt.port, "assert.Error(t, cfg.validate())"} {
+				if !strings.Contains(content, want) {
+					t.Errorf("config_test.go should contain %q", want)
+				}
+			}
+
+			fixture := "/output/test-project/internal/config/testdata/config." + tt.format
+			if tt.fixture == "" {
+				if mfs.HasFile(fixture) {
+					t.Error("env configs should not have a testdata fixture")
+				}
+				return
+			}
+			if got := mfs.FileContent(fixture); got != mfs.FileContent("/output/test-project/"+tt.fixture) {
+				t.Errorf("testdata fixture should match %s", tt.fixture)
+			}
+			if !strings.Contains(content, `filepath.Join("testdata", "config.`+tt.format+`")`) {
+				t.Error("config_test.go should load the testdata fixture")
+			}
+		})
+	}
+}
+
+func TestGenerator_ConfigTestsEnvPrefix(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.ConfigFormat = "yaml"
+	cfg.EnvPrefix = "MYAPP"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	content := mfs.FileContent("/output/test-project/internal/config/config_test.go")
+	if !strings.Contains(content, `t.Setenv("MYAPP_PORT", "9090")`) {
+		t.Error("config_test.go should set the prefixed environment variables")
+	}
+}
+
+func TestGenerator_ObservabilityTests(t *testing.T) {
+	tests := []struct {
+		name    string
+		metrics bool
+	}{
+		{"with metrics", true},
+		{"without metrics", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.EnableMetrics = tt.metrics
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			path := "/output/test-project/internal/observability/observability_test.go"
+			if !mfs.HasFile(path) {
+				t.Fatal("observability_test.go should be generated")
+			}
+			content := mfs.FileContent(path)
+			for _, want := range []string{
+				"obs, err := New(context.Background(), cfg)",
+				"assert.NotNil(t, obs.Logger)",
+				"assert.NoError(t, obs.Shutdown(ctx))",
+				"func TestShutdown_ZeroValue(t *testing.T) {",
+			} {
+				if !strings.Contains(content, want) {
+					t.Errorf("observability_test.go should contain %q", want)
+				}
+			}
+
+			// MetricsHandler only exists with metrics enabled
+			if got := strings.Contains(content, "obs.MetricsHandler().ServeHTTP"); got != tt.metrics {
+				t.Errorf("MetricsHandler test present = %v, want %v", got, tt.metrics)
+			}
+			if got := strings.Contains(content, `"net/http/httptest"`); got != tt.metrics {
+				t.Errorf("httptest import present = %v, want %v", got, tt.metrics)
+			}
+		})
+	}
+}
+
+func TestGenerator_ObservabilityTestsOTLPEndpoint(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"env", `OTLPEndpoint: "localhost:4317",`},
+		{"yaml", `Tracing: config.TracingConfig{OTLPEndpoint: "localhost:4317"},`},
+		{"hcl", `Tracing: config.TracingConfig{OTLPEndpoint: "localhost:4317"},`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.ConfigFormat = tt.format
+			cfg.EnableTracing = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			// New fails to build the OTLP exporter without an endpoint
+			if !strings.Contains(mfs.FileContent("/output/test-project/internal/observability/observability_test.go"), tt.want) {
+				t.Errorf("observability_test.go config should contain %q", tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultDependencyTimeout is the default bound of each database and cache
+// operation, e.g. the connection ping, as a Go duration string.
+const defaultDependencyTimeout = "5s"
+
+// needsDatabaseTimeout reports whether a SQL or NoSQL database is configured,
+// as those share the DB_TIMEOUT bound.
+func (g *Generator) needsDatabaseTimeout() bool {
+	return g.config.NeedsSQL() || g.config.NeedsNoSQL()
+}
+
+// getTimeoutConfigFields returns the DBTimeout and CacheTimeout fields of the
+// env format Config for the configured dependencies.
+func (g *Generator) getTimeoutConfigFields() string {
+	var sb strings.Builder
+	if g.needsDatabaseTimeout() {
+		sb.WriteString(`
+	// DBTimeout bounds each database operation, e.g. the connection ping.
+	DBTimeout time.Duration
+`)
+	}
+	if g.config.NeedsCache() {
+		sb.WriteString(`
+	// CacheTimeout bounds each cache operation, e.g. the connection ping.
+	CacheTimeout time.Duration
+`)
+	}
+	return sb.String()
+}
+
+// getTimeoutLoadStatements returns the env format Load statements reading
+// DB_TIMEOUT and CACHE_TIMEOUT.
+func (g *Generator) getTimeoutLoadStatements() []string {
+	var statements []string
+	if g.needsDatabaseTimeout() {
+		statements = append(statements, fmt.Sprintf(`	cfg.DBTimeout = getEnvDuration("%s", 5*time.Second)`, g.envVar("DB_TIMEOUT")))
+	}
+	if g.config.NeedsCache() {
+		statements = append(statements, fmt.Sprintf(`	cfg.CacheTimeout = getEnvDuration("%s", 5*time.Second)`, g.envVar("CACHE_TIMEOUT")))
+	}
+	return statements
+}
+
+// getTimeoutStructField returns the Timeout field of the DatabaseConfig or
+// CacheConfig section of a structured config, tagged for the given format.
+func getTimeoutStructField(tag, section string) string {
+	return fmt.Sprintf("\n\t// Timeout bounds each %s operation, e.g. the connection ping.\n\tTimeout string %s\n", section, fieldTag(tag, "timeout"))
+}
+
+// getTimeoutEnvFallbackStatements returns the loadFromEnv statements reading
+// DB_TIMEOUT and CACHE_TIMEOUT into a structured config.
+func (g *Generator) getTimeoutEnvFallbackStatements() string {
+	var statements []string
+	if g.needsDatabaseTimeout() {
+		statements = append(statements, fmt.Sprintf("\tcfg.Database.Timeout = getEnv(%q, %q)\n", g.envVar("DB_TIMEOUT"), defaultDependencyTimeout))
+	}
+	if g.config.NeedsCache() {
+		statements = append(statements, fmt.Sprintf("\tcfg.Cache.Timeout = getEnv(%q, %q)\n", g.envVar("CACHE_TIMEOUT"), defaultDependencyTimeout))
+	}
+	if len(statements) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(statements, "")
+}
+
+// getTimeoutEnvOverrides returns the applyEnvOverrides statements letting
+// DB_TIMEOUT and CACHE_TIMEOUT override the config file.
+func (g *Generator) getTimeoutEnvOverrides() string {
+	var sb strings.Builder
+	if g.needsDatabaseTimeout() {
+		sb.WriteString(fmt.Sprintf(`
+	if timeout := os.Getenv("%s"); timeout != "" {
+		c.Database.Timeout = timeout
+	}`, g.envVar("DB_TIMEOUT")))
+	}
+	if g.config.NeedsCache() {
+		sb.WriteString(fmt.Sprintf(`
+	if timeout := os.Getenv("%s"); timeout != "" {
+		c.Cache.Timeout = timeout
+	}`, g.envVar("CACHE_TIMEOUT")))
+	}
+	return sb.String()
+}
+
+// getTimeoutAccessors returns the GetDBTimeout and GetCacheTimeout accessors
+// of a structured config. An unset or invalid timeout falls back to the
+// default rather than expiring every operation immediately.
+func (g *Generator) getTimeoutAccessors() string {
+	var sb strings.Builder
+	if g.needsDatabaseTimeout() {
+		sb.WriteString(`
+// GetDBTimeout returns the bound of each database operation
+func (c *Config) GetDBTimeout() time.Duration {
+	return timeoutOrDefault(c.Database.Timeout)
+}
+`)
+	}
+	if g.config.NeedsCache() {
+		sb.WriteString(`
+// GetCacheTimeout returns the bound of each cache operation
+func (c *Config) GetCacheTimeout() time.Duration {
+	return timeoutOrDefault(c.Cache.Timeout)
+}
+`)
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return sb.String() + `
+// timeoutOrDefault parses timeout, falling back to 5s when it is unset or
+// not a positive duration.
+func timeoutOrDefault(timeout string) time.Duration {
+	if d, err := time.ParseDuration(timeout); err == nil && d > 0 {
+		return d
+	}
+	return 5 * time.Second
+}
+`
+}
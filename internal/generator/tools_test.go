@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ToolsFile(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	tools := mfs.FileContent("/output/test-project/tools/tools.go")
+	for _, check := range []string{
+		"//go:build tools",
+		"package tools",
+		`_ "go.uber.org/mock/mockgen"`,
+		`_ "github.com/golangci/golangci-lint/cmd/golangci-lint"`,
+	} {
+		if !strings.Contains(tools, check) {
+			t.Errorf("tools/tools.go should contain %q", check)
+		}
+	}
+
+	goMod := mfs.FileContent("/output/test-project/go.mod")
+	if !strings.Contains(goMod, "github.com/golangci/golangci-lint v1.59.1") {
+		t.Error("go.mod should pin golangci-lint")
+	}
+	if strings.Count(goMod, "go.uber.org/mock ") != 1 {
+		t.Error("go.mod should require go.uber.org/mock exactly once")
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	if !strings.Contains(makefile, "\ntools: ## ") {
+		t.Error("Makefile should define a tools target")
+	}
+	if !strings.Contains(makefile, "@go install go.uber.org/mock/mockgen github.com/golangci/golangci-lint/cmd/golangci-lint") {
+		t.Error("make tools should install the module-pinned tools")
+	}
+	if strings.Contains(makefile, "@latest") {
+		t.Error("Makefile should not install tools @latest")
+	}
+}
@@ -0,0 +1,186 @@
+package generator
+
+import (
+	"fmt"
+)
+
+// getTraceIDMiddleware returns the middleware echoing the trace ID in the
+// --trace-id-header response header, or "" without it. The trace ID is that
+// of the active span when tracing, and the request ID otherwise; gin and
+// fiber, which set no request ID, fall back to the X-Request-ID request
+// header.
+func (g *Generator) getTraceIDMiddleware() string {
+	if g.config.TraceIDHeader == "" {
+		return ""
+	}
+
+	var mw string
+	switch g.config.Framework {
+	case "gin":
+		mw = fmt.Sprintf(`
+// GinTraceID sets the header of the response to the ID of the request, so
+// clients can quote it when reporting an error.
+func GinTraceID(header string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if id := %s; id != "" {
+			c.Header(header, id)
+		}
+		c.Next()
+	}
+}
+`, g.getTraceIDExpr("c.Request.Context()", `c.GetHeader("X-Request-ID")`))
+	case "fiber":
+		mw = `
+// FiberTraceID sets the header of the response to the ID of the request, so
+// clients can quote it when reporting an error.
+func FiberTraceID(header string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(fiber.HeaderXRequestID)` + g.getTraceIDFromLocal(`c.Locals("trace_ctx")`) + `
+		if id != "" {
+			c.Set(header, id)
+		}
+		return c.Next()
+	}
+}
+`
+	case "fasthttp":
+		mw = `
+// FastHTTPTraceID sets the header of the response to the ID of the request,
+// so clients can quote it when reporting an error. The header is set once the
+// request is served, as ctx.Error resets the response headers.
+func FastHTTPTraceID(next fasthttp.RequestHandler, header string) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		next(ctx)
+
+		id, _ := ctx.UserValue(string(RequestIDKey)).(string)` + g.getTraceIDFromLocal(`ctx.UserValue("trace_ctx")`) + `
+		if id != "" {
+			ctx.Response.Header.Set(header, id)
+		}
+	}
+}
+`
+	default:
+		// net/http middleware, also used by chi and, wrapped, by echo
+		requestID := "requestIDFromContext(r.Context())"
+		switch {
+		case g.config.ExportMiddleware:
+			requestID = "httpmw.RequestIDFromContext(r.Context())"
+		case g.config.Framework == "chi":
+			requestID = "middleware.GetReqID(r.Context())"
+		case g.config.Framework == "echo":
+			// Set on the response by echo's RequestID middleware
+			requestID = "w.Header().Get(echo.HeaderXRequestID)"
+		}
+		mw = fmt.Sprintf(`
+// TraceID sets the header of the response to the ID of the request, so
+// clients can quote it when reporting an error.
+func TraceID(header string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if id := %s; id != "" {
+				w.Header().Set(header, id)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+`, g.getTraceIDExpr("r.Context()", requestID))
+		if requestID == "requestIDFromContext(r.Context())" {
+			mw += `
+// requestIDFromContext returns the request ID set by RequestID, or "".
+func requestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestIDKey).(string)
+	return requestID
+}
+`
+		}
+	}
+
+	if g.config.EnableTracing {
+		mw += `
+// traceID returns the trace ID of the span active in ctx, or requestID
+// outside of a trace.
+func traceID(ctx context.Context, requestID string) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return requestID
+}
+`
+	}
+	return mw
+}
+
+// getTraceIDExpr returns the expression of the ID a TraceID middleware echoes:
+// the trace ID of the span in ctx when tracing, else requestID.
+func (g *Generator) getTraceIDExpr(ctx, requestID string) string {
+	if !g.config.EnableTracing {
+		return requestID
+	}
+	return fmt.Sprintf("traceID(%s, %s)", ctx, requestID)
+}
+
+// getTraceIDFromLocal returns the statement replacing id with the trace ID of
+// the span context the fiber and fasthttp Tracing middleware store in local,
+// or "" without tracing.
+func (g *Generator) getTraceIDFromLocal(local string) string {
+	if !g.config.EnableTracing {
+		return ""
+	}
+	return fmt.Sprintf(`
+		if ctx, ok := %s.(context.Context); ok {
+			id = traceID(ctx, id)
+		}`, local)
+}
+
+// getTraceIDHeaderExpr returns the quoted --trace-id-header the server passes
+// to the TraceID middleware, or "" without it.
+func (g *Generator) getTraceIDHeaderExpr() string {
+	if g.config.TraceIDHeader == "" {
+		return ""
+	}
+	return fmt.Sprintf("%q", g.config.TraceIDHeader)
+}
+
+// getTraceIDMiddlewareTests returns the generated tests of the net/http
+// TraceID middleware.
+func (g *Generator) getTraceIDMiddlewareTests() string {
+	if g.config.TraceIDHeader == "" {
+		return ""
+	}
+
+	requestID := "RequestID"
+	if g.config.ExportMiddleware {
+		requestID = "httpmw.RequestID"
+	}
+	tests := fmt.Sprintf(`
+func TestTraceID_EchoesRequestID(t *testing.T) {
+	h := %[1]s(TraceID(%[2]q)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.NotEmpty(t, w.Header().Get(%[2]q))
+	assert.Equal(t, w.Header().Get("X-Request-ID"), w.Header().Get(%[2]q))
+}
+`, requestID, g.config.TraceIDHeader)
+
+	if g.config.EnableTracing {
+		tests += fmt.Sprintf(`
+func TestTraceID_EchoesActiveSpanTraceID(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x01},
+	})
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r = r.WithContext(trace.ContextWithSpanContext(r.Context(), sc))
+
+	w := httptest.NewRecorder()
+	TraceID(%[1]q)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(w, r)
+
+	assert.Equal(t, sc.TraceID().String(), w.Header().Get(%[1]q))
+}
+`, g.config.TraceIDHeader)
+	}
+	return tests
+}
@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_TraceIDHeader(t *testing.T) {
+	tests := []struct {
+		framework  string
+		middleware string
+		registered string
+	}{
+		{"stdlib", "func TraceID(header string) Middleware {", `middleware.TraceID("X-Trace-ID"),`},
+		{"chi", "func TraceID(header string) Middleware {", `r.Use(custommw.TraceID("X-Trace-ID"))`},
+		{"gin", "func GinTraceID(header string) gin.HandlerFunc {", `r.Use(middleware.GinTraceID("X-Trace-ID"))`},
+		{"echo", "func TraceID(header string) Middleware {", `s.echo.Use(echo.WrapMiddleware(custommw.TraceID("X-Trace-ID")))`},
+		{"fiber", "func FiberTraceID(header string) fiber.Handler {", `s.app.Use(middleware.FiberTraceID("X-Trace-ID"))`},
+		{"fasthttp", "func FastHTTPTraceID(next fasthttp.RequestHandler, header string) fasthttp.RequestHandler {", `h = middleware.FastHTTPTraceID(h, "X-Trace-ID")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.EnableTracing = true
+			cfg.TraceIDHeader = "X-Trace-ID"
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+			if !strings.Contains(middleware, tt.middleware) {
+				t.Errorf("middleware.go should define %q", tt.middleware)
+			}
+			if !strings.Contains(middleware, "if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {") {
+				t.Error("the trace ID should come from the active span when tracing")
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.registered) {
+				t.Errorf("server.go should register the middleware with %q", tt.registered)
+			}
+		})
+	}
+}
+
+func TestGenerator_TraceIDHeaderWithoutTracing(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.TraceIDHeader = "X-Correlation-ID"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if !strings.Contains(middleware, "if id := requestIDFromContext(r.Context()); id != \"\" {") {
+		t.Error("TraceID should echo the request ID without tracing")
+	}
+	if strings.Contains(middleware, "func traceID(") {
+		t.Error("middleware.go should not read spans without tracing")
+	}
+
+	tests := mfs.FileContent("/output/test-project/internal/middleware/middleware_test.go")
+	if !strings.Contains(tests, `assert.Equal(t, w.Header().Get("X-Request-ID"), w.Header().Get("X-Correlation-ID"))`) {
+		t.Error("middleware_test.go should assert the response carries the configured header")
+	}
+	if strings.Contains(tests, "TestTraceID_EchoesActiveSpanTraceID") {
+		t.Error("middleware_test.go should not test spans without tracing")
+	}
+}
+
+func TestGenerator_TraceIDHeaderDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/middleware/middleware.go"), "TraceID") {
+		t.Error("middleware.go should not define TraceID without --trace-id-header")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), "TraceID") {
+		t.Error("server.go should not register TraceID without --trace-id-header")
+	}
+}
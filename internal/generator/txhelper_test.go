@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_TxHelper(t *testing.T) {
+	tests := []struct {
+		name      string
+		databases []string
+		want      []string
+		notWant   []string
+	}{
+		{
+			name:      "postgres",
+			databases: []string{"postgres"},
+			want: []string{
+				"func (db *PostgresDB) WithTx(ctx context.Context, fn func(tx pgx.Tx) error) error {",
+				"tx, err := db.pool.BeginTx(ctx, pgx.TxOptions{})",
+				"defer func() { _ = tx.Rollback(ctx) }()",
+				"if err := tx.Commit(ctx); err != nil {",
+			},
+			notWant: []string{"MySQLDB", `"database/sql"`},
+		},
+		{
+			name:      "mysql",
+			databases: []string{"mysql"},
+			want: []string{
+				"func (db *MySQLDB) WithTx(ctx context.Context, fn func(tx *sql.Tx) error) error {",
+				"tx, err := db.db.BeginTx(ctx, nil)",
+				"defer func() { _ = tx.Rollback() }()",
+				"if err := tx.Commit(); err != nil {",
+			},
+			notWant: []string{"PostgresDB", "pgx"},
+		},
+		{
+			name:      "postgres and mysql",
+			databases: []string{"postgres", "mysql"},
+			want: []string{
+				"func (db *PostgresDB) WithTx(",
+				"func (db *MySQLDB) WithTx(",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Databases = tt.databases
+			cfg.TxHelper = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			tx := mfs.FileContent("/output/test-project/internal/database/tx.go")
+			// The callback's error is returned before Commit, leaving the
+			// deferred Rollback to undo the transaction
+			if !strings.Contains(tx, "if err := fn(tx); err != nil {\n\t\treturn err\n\t}") {
+				t.Error("WithTx should return the callback's error without committing")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(tx, want) {
+					t.Errorf("tx.go should contain %q", want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(tx, notWant) {
+					t.Errorf("tx.go should not contain %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_TxHelperDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Databases = []string{"postgres"}
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/internal/database/tx.go") {
+		t.Error("tx.go should not be generated without --tx-helper")
+	}
+}
@@ -0,0 +1,259 @@
+package generator
+
+import "fmt"
+
+func (g *Generator) generateValidatorPackage() error {
+	content := `package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	once     sync.Once
+	instance *validator.Validate
+)
+
+// FieldError describes a single failed validation rule in a JSON-friendly form.
+type FieldError struct {
+	Field   string ` + "`json:\"field\"" + g.getXMLTag("field") + "`" + `
+	Rule    string ` + "`json:\"rule\"" + g.getXMLTag("rule") + "`" + `
+	Message string ` + "`json:\"message\"" + g.getXMLTag("message") + "`" + `
+}
+
+// Validator returns the shared validator instance.
+// Field names in errors are taken from json tags so they match the request body.
+func Validator() *validator.Validate {
+	once.Do(func() {
+		instance = validator.New(validator.WithRequiredStructEnabled())
+		instance.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return field.Name
+			}
+			return name
+		})
+	})
+	return instance
+}
+
+// Struct validates s using its validate struct tags.
+func Struct(s any) error {
+	return Validator().Struct(s)
+}
+
+// FieldErrors converts a validation error into a list of field errors.
+// It returns nil if err is not a validation error.
+func FieldErrors(err error) []FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fe.Field(),
+			Rule:    fe.Tag(),
+			Message: message(fe),
+		})
+	}
+	return fieldErrors
+}
+
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", fe.Field())
+	default:
+		return fmt.Sprintf("%s failed the %s rule", fe.Field(), fe.Tag())
+	}
+}
+`
+	return g.writeFile("pkg/validate/validate.go", content)
+}
+
+// getValidatorHandlers returns the example POST handler demonstrating request validation.
+func (g *Generator) getValidatorHandlers() string {
+	if !g.config.Validator {
+		return ""
+	}
+
+	var handler string
+	switch g.config.Framework {
+	case "gin":
+		handler = `func (h *Handler) CreateUserGin(c *gin.Context) {
+	var req CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, Response{Status: "error", Message: "invalid JSON body"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+	case "echo":
+		handler = `func (h *Handler) CreateUserEcho(c echo.Context) error {
+	var req CreateUserRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, Response{Status: "error", Message: "invalid JSON body"})
+	}
+	if err := validate.Struct(req); err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+	}
+	return c.JSON(http.StatusCreated, Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+	case "fiber":
+		handler = `func (h *Handler) CreateUserFiber(c *fiber.Ctx) error {
+	var req CreateUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(Response{Status: "error", Message: "invalid JSON body"})
+	}
+	if err := validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+	}
+	return c.Status(fiber.StatusCreated).JSON(Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+	case "fasthttp":
+		handler = `func (h *Handler) CreateUserFastHTTP(ctx *fasthttp.RequestCtx) {
+	if !ctx.IsPost() {
+		writeFastHTTPJSON(ctx, fasthttp.StatusMethodNotAllowed, Response{Status: "error", Message: "method not allowed"})
+		return
+	}
+
+	var req CreateUserRequest
+	if err := json.Unmarshal(ctx.PostBody(), &req); err != nil {
+		writeFastHTTPJSON(ctx, fasthttp.StatusBadRequest, Response{Status: "error", Message: "invalid JSON body"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		writeFastHTTPJSON(ctx, fasthttp.StatusUnprocessableEntity, ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+		return
+	}
+
+	writeFastHTTPJSON(ctx, fasthttp.StatusCreated, Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+	default:
+		handler = `func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		json.NewEncoder(w).Encode(Response{Status: "error", Message: "method not allowed"})
+		return
+	}
+
+	var req CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(Response{Status: "error", Message: "invalid JSON body"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+		if g.config.ContentNegotiation {
+			handler = `func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		respond(w, r, http.StatusMethodNotAllowed, Response{Status: "error", Message: "method not allowed"})
+		return
+	}
+
+	var req CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respond(w, r, http.StatusBadRequest, Response{Status: "error", Message: "invalid JSON body"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		respond(w, r, http.StatusUnprocessableEntity, ValidationErrorResponse{
+			Status: "error",
+			Errors: validate.FieldErrors(err),
+		})
+		return
+	}
+
+	respond(w, r, http.StatusCreated, Response{
+		Status: "created",
+		Data:   map[string]interface{}{"name": req.Name, "email": req.Email},
+	})
+}`
+		}
+	}
+
+	return fmt.Sprintf(`
+// CreateUserRequest is an example request body validated with struct tags.
+type CreateUserRequest struct {
+	Name  string `+"`json:\"name\" validate:\"required\"`"+`
+	Email string `+"`json:\"email\" validate:\"required,email\"`"+`
+}
+
+// ValidationErrorResponse reports every field that failed validation.
+%s
+
+%s
+`, g.getValidationErrorResponseType(), handler)
+}
+
+// getValidationErrorResponseType returns the ValidationErrorResponse type,
+// encoding to XML as a <response> like Response with --content-negotiation.
+func (g *Generator) getValidationErrorResponseType() string {
+	if !g.config.ContentNegotiation {
+		return `type ValidationErrorResponse struct {
+	Status string                ` + "`json:\"status\"`" + `
+	Errors []validate.FieldError ` + "`json:\"errors\"`" + `
+}`
+	}
+	return `type ValidationErrorResponse struct {
+	XMLName xml.Name              ` + "`json:\"-\" xml:\"response\"`" + `
+	Status  string                ` + "`json:\"status\" xml:\"status\"`" + `
+	Errors  []validate.FieldError ` + "`json:\"errors\" xml:\"errors>error\"`" + `
+}`
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Validator(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Validator = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	path := "/output/test-project/pkg/validate/validate.go"
+	if !mfs.HasFile(path) {
+		t.Fatal("Expected pkg/validate/validate.go to exist")
+	}
+
+	content := mfs.FileContent(path)
+	for _, check := range []string{
+		"package validate",
+		"github.com/go-playground/validator/v10",
+		"func Validator() *validator.Validate",
+		"func FieldErrors(err error) []FieldError",
+	} {
+		if !strings.Contains(content, check) {
+			t.Errorf("validate.go should contain %q", check)
+		}
+	}
+
+	handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+	for _, check := range []string{
+		`validate:"required,email"`,
+		"func (h *Handler) CreateUser(",
+		"github.com/test/test-project/pkg/validate",
+	} {
+		if !strings.Contains(handlers, check) {
+			t.Errorf("handlers.go should contain %q", check)
+		}
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, `mux.HandleFunc("/users", handler.CreateUser)`) {
+		t.Error("server.go should register the example POST handler")
+	}
+
+	goMod := mfs.FileContent("/output/test-project/go.mod")
+	if !strings.Contains(goMod, "github.com/go-playground/validator/v10") {
+		t.Error("go.mod should require go-playground/validator")
+	}
+}
+
+func TestGenerator_ValidatorDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/pkg/validate/validate.go") {
+		t.Error("pkg/validate should not be generated without --validator")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "go-playground/validator") {
+		t.Error("go.mod should not require go-playground/validator without --validator")
+	}
+}
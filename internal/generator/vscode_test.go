@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_VSCode(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.VSCode = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	var launch struct {
+		Configurations []struct {
+			Program string `json:"program"`
+			EnvFile string `json:"envFile"`
+		} `json:"configurations"`
+	}
+	if err := json.Unmarshal([]byte(mfs.FileContent("/output/test-project/.vscode/launch.json")), &launch); err != nil {
+		t.Fatalf("launch.json is not valid JSON: %v", err)
+	}
+	if len(launch.Configurations) != 1 {
+		t.Fatalf("launch.json should have one configuration, got %d", len(launch.Configurations))
+	}
+	if got := launch.Configurations[0].Program; got != "${workspaceFolder}/cmd/test-project" {
+		t.Errorf("launch.json program = %q, want the cmd/test-project path", got)
+	}
+	if got := launch.Configurations[0].EnvFile; got != "${workspaceFolder}/.env" {
+		t.Errorf("launch.json envFile = %q, want .env", got)
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal([]byte(mfs.FileContent("/output/test-project/.vscode/settings.json")), &settings); err != nil {
+		t.Fatalf("settings.json is not valid JSON: %v", err)
+	}
+	if settings["go.lintTool"] != "golangci-lint" {
+		t.Error("settings.json should lint with golangci-lint")
+	}
+
+	gitignore := mfs.FileContent("/output/test-project/.gitignore")
+	if !strings.Contains(gitignore, "!.vscode/launch.json\n") || strings.Contains(gitignore, ".vscode/\n") {
+		t.Error(".gitignore should keep the generated VS Code files tracked")
+	}
+}
+
+func TestGenerator_VSCodeDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if mfs.HasFile("/output/test-project/.vscode/launch.json") {
+		t.Error(".vscode/launch.json should not be generated unless --vscode is set")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/.gitignore"), ".vscode/\n") {
+		t.Error(".gitignore should ignore .vscode/ by default")
+	}
+}
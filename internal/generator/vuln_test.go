@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_VulnTarget(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	makefile := mfs.FileContent("/output/test-project/Makefile")
+	if !strings.Contains(makefile, "\nvuln: ## ") || !strings.Contains(makefile, "@govulncheck ./...") {
+		t.Error("Makefile should define a vuln target running govulncheck")
+	}
+	if strings.Contains(makefile, "no-print-directory vuln") {
+		t.Error("make ci should not run vuln unless --vuln-check is set")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/tools/tools.go"), `_ "golang.org/x/vuln/cmd/govulncheck"`) {
+		t.Error("tools/tools.go should pin govulncheck so make tools installs it")
+	}
+	if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), "golang.org/x/vuln v1.1.3") {
+		t.Error("go.mod should pin golang.org/x/vuln")
+	}
+}
+
+func TestGenerator_VulnCheckCI(t *testing.T) {
+	tests := []struct {
+		ci   string
+		path string
+		job  string
+	}{
+		{"github", ".github/workflows/ci.yml", "  vuln:\n    name: Vulnerability Check"},
+		{"gitlab", ".gitlab-ci.yml", "\nvuln:\n  stage: test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ci, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.CI = tt.ci
+			cfg.VulnCheck = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			ci := mfs.FileContent("/output/test-project/" + tt.path)
+			if !strings.Contains(ci, tt.job) {
+				t.Errorf("%s should define a vuln job", tt.path)
+			}
+			if !strings.Contains(ci, "go run golang.org/x/vuln/cmd/govulncheck ./...") {
+				t.Errorf("%s should run the pinned govulncheck", tt.path)
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/Makefile"), "@$(MAKE) --no-print-directory vuln") {
+				t.Error("make ci should run vuln like CI")
+			}
+		})
+	}
+}
+
+func TestGenerator_VulnCheckCIDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.CI = "github"
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	ci := mfs.FileContent("/output/test-project/.github/workflows/ci.yml")
+	if strings.Contains(ci, "govulncheck") {
+		t.Error("ci.yml should not run govulncheck unless --vuln-check is set")
+	}
+	if !strings.Contains(ci, "needs: [test, lint]\n") {
+		t.Error("the build job should only wait for test and lint")
+	}
+}
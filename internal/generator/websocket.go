@@ -0,0 +1,122 @@
+package generator
+
+// usesFastHTTPWebSocket reports whether the WebSocket endpoint upgrades
+// through fasthttp/websocket, as fiber and fasthttp are not net/http based.
+func (g *Generator) usesFastHTTPWebSocket() bool {
+	return g.config.Framework == "fiber" || g.config.Framework == "fasthttp"
+}
+
+// getWebSocketImports returns the handlers.go imports of the WebSocket
+// endpoint, or nil when it is disabled.
+func (g *Generator) getWebSocketImports() []string {
+	if !g.config.EnableWebSocket {
+		return nil
+	}
+	if g.usesFastHTTPWebSocket() {
+		return []string{`"github.com/fasthttp/websocket"`}
+	}
+	return []string{`"context"`, `"github.com/coder/websocket"`}
+}
+
+// getWebSocketHandlers returns the /ws handler echoing every message back
+// for the configured framework, or "" when the endpoint is disabled.
+func (g *Generator) getWebSocketHandlers() string {
+	if !g.config.EnableWebSocket {
+		return ""
+	}
+
+	if g.usesFastHTTPWebSocket() {
+		handler := `
+// WebSocketFastHTTP upgrades the request to a WebSocket connection that
+// echoes every message back. Upgrade answers failed handshakes itself.
+func (h *Handler) WebSocketFastHTTP(ctx *fasthttp.RequestCtx) {
+	_ = webSocketUpgrader.Upgrade(ctx, echoWebSocket)
+}
+`
+		if g.config.Framework == "fiber" {
+			handler = `
+// WebSocketFiber upgrades the request to a WebSocket connection that echoes
+// every message back. Upgrade answers failed handshakes itself.
+func (h *Handler) WebSocketFiber(c *fiber.Ctx) error {
+	_ = webSocketUpgrader.Upgrade(c.Context(), echoWebSocket)
+	return nil
+}
+`
+		}
+		return `
+// webSocketUpgrader only accepts WebSocket handshakes from the same origin.
+var webSocketUpgrader = websocket.FastHTTPUpgrader{}
+` + handler + `
+// echoWebSocket writes every message read from conn back until the client
+// closes the connection.
+func echoWebSocket(conn *websocket.Conn) {
+	defer conn.Close()
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if err := conn.WriteMessage(messageType, message); err != nil {
+			return
+		}
+	}
+}
+`
+	}
+
+	handler := `
+// WebSocket upgrades the request to a WebSocket connection that echoes every
+// message back until the client closes it. Only same-origin handshakes are
+// accepted.
+func (h *Handler) WebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return // Accept has already answered the failed handshake
+	}
+	defer conn.CloseNow()
+
+	// The connection outlives request timeouts, so only closing ends it
+	ctx := context.WithoutCancel(r.Context())
+	for {
+		messageType, message, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+		if err := conn.Write(ctx, messageType, message); err != nil {
+			return
+		}
+	}
+}
+`
+	switch g.config.Framework {
+	case "gin":
+		handler += `
+func (h *Handler) WebSocketGin(c *gin.Context) {
+	h.WebSocket(c.Writer, c.Request)
+}
+`
+	case "echo":
+		handler += `
+func (h *Handler) WebSocketEcho(c echo.Context) error {
+	h.WebSocket(c.Response(), c.Request())
+	return nil
+}
+`
+	}
+	return handler
+}
+
+// getResponseRecorderHijack returns the responseRecorder Hijack method that
+// lets WebSocket handshakes take over connections wrapped by the net/http
+// middleware, or "" when the WebSocket endpoint is disabled.
+func (g *Generator) getResponseRecorderHijack() string {
+	if !g.config.EnableWebSocket {
+		return ""
+	}
+	return `
+// Hijack lets WebSocket handshakes take over the connection.
+func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(r.ResponseWriter).Hijack()
+}
+`
+}
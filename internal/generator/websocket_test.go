@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerator_WebSocket(t *testing.T) {
+	tests := []struct {
+		framework string
+		route     string
+		library   string
+	}{
+		{"stdlib", `mux.HandleFunc("/ws", handler.WebSocket)`, "github.com/coder/websocket"},
+		{"chi", `r.Get("/ws", handler.WebSocket)`, "github.com/coder/websocket"},
+		{"gin", `r.GET("/ws", handler.WebSocketGin)`, "github.com/coder/websocket"},
+		{"echo", `s.echo.GET("/ws", handler.WebSocketEcho)`, "github.com/coder/websocket"},
+		{"fiber", `s.app.Get("/ws", handler.WebSocketFiber)`, "github.com/fasthttp/websocket"},
+		{"fasthttp", `"/ws":`, "github.com/fasthttp/websocket"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			cfg := createTestConfig()
+			cfg.Framework = tt.framework
+			cfg.EnableWebSocket = true
+			gen, mfs := createTestGenerator(cfg)
+
+			if err := gen.Generate(); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			server := mfs.FileContent("/output/test-project/internal/server/server.go")
+			if !strings.Contains(server, tt.route) {
+				t.Errorf("server.go should register the /ws route with %q", tt.route)
+			}
+
+			handlers := mfs.FileContent("/output/test-project/internal/handlers/handlers.go")
+			if !strings.Contains(handlers, `"`+tt.library+`"`) {
+				t.Errorf("handlers.go should import %s", tt.library)
+			}
+
+			if !strings.Contains(mfs.FileContent("/output/test-project/go.mod"), tt.library) {
+				t.Errorf("go.mod should require %s", tt.library)
+			}
+		})
+	}
+}
+
+func TestGenerator_WebSocketHijacksThroughMiddleware(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.EnableWebSocket = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	middleware := mfs.FileContent("/output/test-project/internal/middleware/middleware.go")
+	if !strings.Contains(middleware, "func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {") {
+		t.Error("responseRecorder should support Hijack so the WebSocket handshake passes the middleware")
+	}
+}
+
+func TestGenerator_WebSocketDisabled(t *testing.T) {
+	cfg := createTestConfig()
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/server/server.go"), `"/ws"`) {
+		t.Error("server.go should not register /ws unless enabled")
+	}
+	if strings.Contains(mfs.FileContent("/output/test-project/internal/handlers/handlers.go"), "websocket") {
+		t.Error("handlers.go should not import a websocket library unless enabled")
+	}
+}
+
+func TestGenerator_WebSocketSkipsEchoTimeout(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Framework = "echo"
+	cfg.EnableWebSocket = true
+	gen, mfs := createTestGenerator(cfg)
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	server := mfs.FileContent("/output/test-project/internal/server/server.go")
+	if !strings.Contains(server, `Skipper: func(c echo.Context) bool { return c.Path() == "/ws" },`) {
+		t.Error("echo's timeout middleware should skip /ws so the handshake can hijack the connection")
+	}
+}
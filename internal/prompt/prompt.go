@@ -3,44 +3,129 @@ package prompt
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/anwam/go-template-sh/internal/config"
 )
 
+// field is a single interactive question that fills part of the config.
+type field struct {
+	label string
+	ask   func(cfg *config.Config) error
+}
+
+// fields lists the questions asked after the project name, in order.
+var fields = []field{
+	{"Module path", askModulePath},
+	{"Go version", askGoVersion},
+	{"HTTP framework", askFramework},
+	{"Databases", askDatabases},
+	{"Logger", askLogger},
+	{"Tracing", askTracing},
+	{"Metrics", askMetrics},
+	{"Docker", askDocker},
+	{"CI/CD", askCI},
+	{"Config format", askConfigFormat},
+	{".env.example", askEnvSample},
+}
+
+// doneOption ends the edit loop in EditConfiguration.
+const doneOption = "Nothing, continue"
+
 func CollectConfiguration(projectName string) (*config.Config, error) {
-	cfg := &config.Config{}
+	cfg := &config.Config{ProjectName: projectName}
 
 	if projectName == "" {
-		namePrompt := &survey.Input{
-			Message: "Project name:",
-			Help:    "Name of your project (e.g., my-api-service)",
+		if err := askProjectName(cfg); err != nil {
+			return nil, err
 		}
-		if err := survey.AskOne(namePrompt, &projectName, survey.WithValidator(validateProjectName)); err != nil {
+	}
+
+	for _, f := range fields {
+		if err := f.ask(cfg); err != nil {
 			return nil, err
 		}
 	}
-	cfg.ProjectName = projectName
 
+	return cfg, nil
+}
+
+// EditConfiguration lets the user re-answer individual questions after the
+// prompt flow instead of starting over. showSummary is called after each edit.
+func EditConfiguration(cfg *config.Config, showSummary func(*config.Config)) error {
+	editable := editableFields()
+	options := []string{doneOption}
+	for _, f := range editable {
+		options = append(options, f.label)
+	}
+
+	for {
+		editPrompt := &survey.Select{
+			Message: "Edit a setting before generating?",
+			Options: options,
+			Default: doneOption,
+		}
+		var choice string
+		if err := survey.AskOne(editPrompt, &choice); err != nil {
+			return err
+		}
+		if choice == doneOption {
+			return nil
+		}
+
+		if err := editField(cfg, editable, choice); err != nil {
+			return err
+		}
+		showSummary(cfg)
+	}
+}
+
+// editableFields returns every question that can be revisited, including the project name.
+func editableFields() []field {
+	return append([]field{{"Project name", askProjectName}}, fields...)
+}
+
+// editField re-asks the question whose label matches choice.
+func editField(cfg *config.Config, fs []field, choice string) error {
+	for _, f := range fs {
+		if f.label == choice {
+			return f.ask(cfg)
+		}
+	}
+	return fmt.Errorf("unknown field %q", choice)
+}
+
+func askProjectName(cfg *config.Config) error {
+	namePrompt := &survey.Input{
+		Message: "Project name:",
+		Default: cfg.ProjectName,
+		Help:    "Name of your project (e.g., my-api-service)",
+	}
+	return survey.AskOne(namePrompt, &cfg.ProjectName, survey.WithValidator(validateProjectName))
+}
+
+func askModulePath(cfg *config.Config) error {
 	modulePrompt := &survey.Input{
 		Message: "Go module path:",
-		Default: fmt.Sprintf("github.com/yourusername/%s", projectName),
+		Default: fmt.Sprintf("github.com/yourusername/%s", cfg.ProjectName),
 		Help:    "Go module path (e.g., github.com/username/project)",
 	}
-	if err := survey.AskOne(modulePrompt, &cfg.ModulePath); err != nil {
-		return nil, err
-	}
+	return survey.AskOne(modulePrompt, &cfg.ModulePath)
+}
 
+func askGoVersion(cfg *config.Config) error {
 	goVersionPrompt := &survey.Select{
 		Message: "Go version:",
 		Options: []string{"1.23", "1.22", "1.21"},
 		Default: "1.23",
 	}
-	if err := survey.AskOne(goVersionPrompt, &cfg.GoVersion); err != nil {
-		return nil, err
-	}
+	return survey.AskOne(goVersionPrompt, &cfg.GoVersion)
+}
 
+func askFramework(cfg *config.Config) error {
 	frameworkPrompt := &survey.Select{
 		Message: "HTTP framework:",
 		Options: []string{
@@ -49,15 +134,19 @@ func CollectConfiguration(projectName string) (*config.Config, error) {
 			"Gin",
 			"Echo",
 			"Fiber",
+			"fasthttp",
 		},
 		Help: "Choose your preferred HTTP framework",
 	}
 	var frameworkChoice string
 	if err := survey.AskOne(frameworkPrompt, &frameworkChoice); err != nil {
-		return nil, err
+		return err
 	}
 	cfg.Framework = parseFramework(frameworkChoice)
+	return nil
+}
 
+func askDatabases(cfg *config.Config) error {
 	dbPrompt := &survey.MultiSelect{
 		Message: "Database(s):",
 		Options: []string{
@@ -70,11 +159,14 @@ func CollectConfiguration(projectName string) (*config.Config, error) {
 		Help: "Select one or more databases (use space to select)",
 	}
 	var dbChoices []string
-	if err := survey.AskOne(dbPrompt, &dbChoices); err != nil {
-		return nil, err
+	if err := survey.AskOne(dbPrompt, &dbChoices, survey.WithValidator(validateDatabaseChoices)); err != nil {
+		return err
 	}
 	cfg.Databases = parseDatabases(dbChoices)
+	return nil
+}
 
+func askLogger(cfg *config.Config) error {
 	loggerPrompt := &survey.Select{
 		Message: "Logger:",
 		Options: []string{
@@ -86,51 +178,58 @@ func CollectConfiguration(projectName string) (*config.Config, error) {
 	}
 	var loggerChoice string
 	if err := survey.AskOne(loggerPrompt, &loggerChoice); err != nil {
-		return nil, err
+		return err
 	}
 	cfg.Logger = parseLogger(loggerChoice)
+	return nil
+}
 
+func askTracing(cfg *config.Config) error {
 	tracingPrompt := &survey.Confirm{
 		Message: "Enable distributed tracing (OpenTelemetry)?",
 		Default: true,
 		Help:    "Add OpenTelemetry for distributed tracing",
 	}
-	if err := survey.AskOne(tracingPrompt, &cfg.EnableTracing); err != nil {
-		return nil, err
-	}
+	return survey.AskOne(tracingPrompt, &cfg.EnableTracing)
+}
 
+func askMetrics(cfg *config.Config) error {
 	metricsPrompt := &survey.Confirm{
 		Message: "Enable Prometheus metrics?",
 		Default: true,
 		Help:    "Add Prometheus metrics endpoint",
 	}
-	if err := survey.AskOne(metricsPrompt, &cfg.EnableMetrics); err != nil {
-		return nil, err
-	}
+	return survey.AskOne(metricsPrompt, &cfg.EnableMetrics)
+}
 
+func askDocker(cfg *config.Config) error {
 	dockerPrompt := &survey.Confirm{
 		Message: "Generate Dockerfile and docker-compose.yml?",
 		Default: true,
 	}
-	if err := survey.AskOne(dockerPrompt, &cfg.IncludeDocker); err != nil {
-		return nil, err
-	}
+	return survey.AskOne(dockerPrompt, &cfg.IncludeDocker)
+}
 
-	ciPrompt := &survey.Select{
+func askCI(cfg *config.Config) error {
+	ciPrompt := &survey.MultiSelect{
 		Message: "CI/CD configuration:",
 		Options: []string{
 			"GitHub Actions",
 			"GitLab CI",
 			"None",
 		},
-		Default: "GitHub Actions",
+		Default: []string{"GitHub Actions"},
+		Help:    "Select one or more CI systems, e.g. both to mirror a GitHub repository on GitLab",
 	}
-	var ciChoice string
-	if err := survey.AskOne(ciPrompt, &ciChoice); err != nil {
-		return nil, err
+	var ciChoices []string
+	if err := survey.AskOne(ciPrompt, &ciChoices, survey.WithValidator(validateCIChoices)); err != nil {
+		return err
 	}
-	cfg.CI = parseCI(ciChoice)
+	cfg.CI = parseCI(ciChoices)
+	return nil
+}
 
+func askConfigFormat(cfg *config.Config) error {
 	configFormatPrompt := &survey.Select{
 		Message: "Configuration file format:",
 		Options: []string{
@@ -138,26 +237,26 @@ func CollectConfiguration(projectName string) (*config.Config, error) {
 			"YAML (config.yaml)",
 			"JSON (config.json)",
 			"TOML (config.toml)",
+			"HCL (config.hcl)",
 		},
 		Default: "Environment variables (.env)",
 		Help:    "Choose how your application will load configuration",
 	}
 	var configFormatChoice string
 	if err := survey.AskOne(configFormatPrompt, &configFormatChoice); err != nil {
-		return nil, err
+		return err
 	}
 	cfg.ConfigFormat = parseConfigFormat(configFormatChoice)
+	return nil
+}
 
+func askEnvSample(cfg *config.Config) error {
 	envSamplePrompt := &survey.Confirm{
 		Message: "Generate documented .env.example file?",
 		Default: true,
 		Help:    "Generate a sample .env file with comments explaining each variable",
 	}
-	if err := survey.AskOne(envSamplePrompt, &cfg.EnvSample); err != nil {
-		return nil, err
-	}
-
-	return cfg, nil
+	return survey.AskOne(envSamplePrompt, &cfg.EnvSample)
 }
 
 func validateProjectName(val interface{}) error {
@@ -175,6 +274,38 @@ func validateProjectName(val interface{}) error {
 	return nil
 }
 
+// validateDatabaseChoices rejects selections that combine "None" with a
+// concrete database, since the intent is ambiguous.
+func validateDatabaseChoices(val interface{}) error {
+	return validateNoneChoices(val, "databases")
+}
+
+// validateCIChoices rejects selections that combine "None" with a CI system.
+func validateCIChoices(val interface{}) error {
+	return validateNoneChoices(val, "CI systems")
+}
+
+// validateNoneChoices rejects multi-select answers combining "None" with
+// other options, which are called kind in the error.
+func validateNoneChoices(val interface{}, kind string) error {
+	var choices []string
+	switch v := val.(type) {
+	case []core.OptionAnswer:
+		for _, answer := range v {
+			choices = append(choices, answer.Value)
+		}
+	case []string:
+		choices = v
+	default:
+		return fmt.Errorf("invalid type")
+	}
+
+	if len(choices) > 1 && slices.Contains(choices, "None") {
+		return fmt.Errorf("\"None\" cannot be combined with other %s", kind)
+	}
+	return nil
+}
+
 func parseFramework(choice string) string {
 	switch {
 	case strings.Contains(choice, "net/http"):
@@ -187,12 +318,18 @@ func parseFramework(choice string) string {
 		return "echo"
 	case strings.Contains(choice, "Fiber"):
 		return "fiber"
+	case strings.Contains(choice, "fasthttp"):
+		return "fasthttp"
 	default:
 		return "stdlib"
 	}
 }
 
 func parseDatabases(choices []string) []string {
+	if slices.Contains(choices, "None") {
+		return []string{}
+	}
+
 	var result []string
 	for _, choice := range choices {
 		switch {
@@ -222,15 +359,18 @@ func parseLogger(choice string) string {
 	}
 }
 
-func parseCI(choice string) string {
-	switch {
-	case strings.Contains(choice, "GitHub"):
-		return "github"
-	case strings.Contains(choice, "GitLab"):
-		return "gitlab"
-	default:
-		return ""
+// parseCI returns the comma-separated CI systems of the selected choices.
+func parseCI(choices []string) string {
+	var result []string
+	for _, choice := range choices {
+		switch {
+		case strings.Contains(choice, "GitHub"):
+			result = append(result, "github")
+		case strings.Contains(choice, "GitLab"):
+			result = append(result, "gitlab")
+		}
 	}
+	return strings.Join(result, ",")
 }
 
 func parseConfigFormat(choice string) string {
@@ -241,6 +381,8 @@ func parseConfigFormat(choice string) string {
 		return "json"
 	case strings.Contains(choice, "TOML"):
 		return "toml"
+	case strings.Contains(choice, "HCL"):
+		return "hcl"
 	default:
 		return "env"
 	}
@@ -2,6 +2,9 @@ package prompt
 
 import (
 	"testing"
+
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/anwam/go-template-sh/internal/config"
 )
 
 func TestValidateProjectName(t *testing.T) {
@@ -79,6 +82,7 @@ func TestParseFramework(t *testing.T) {
 		{"Gin", "Gin", "gin"},
 		{"Echo", "Echo", "echo"},
 		{"Fiber", "Fiber", "fiber"},
+		{"fasthttp", "fasthttp", "fasthttp"},
 		{"unknown", "unknown", "stdlib"},
 		{"empty", "", "stdlib"},
 	}
@@ -118,6 +122,80 @@ func TestParseDatabases(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseChoices(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"single database", []string{"PostgreSQL"}, false},
+		{"multiple databases", []string{"PostgreSQL", "Redis (cache)"}, false},
+		{"none only", []string{"None"}, false},
+		{"nothing selected", []string{}, false},
+		{"none mixed with database", []string{"PostgreSQL", "None"}, true},
+		{"option answers mixed", []core.OptionAnswer{{Value: "None"}, {Value: "MySQL"}}, true},
+		{"option answers none only", []core.OptionAnswer{{Value: "None"}}, false},
+		{"invalid type", 123, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDatabaseChoices(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDatabaseChoices(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEditField(t *testing.T) {
+	var asked []string
+	fake := func(label string) field {
+		return field{label, func(cfg *config.Config) error {
+			asked = append(asked, label)
+			cfg.Framework = label
+			return nil
+		}}
+	}
+	fs := []field{fake("HTTP framework"), fake("Logger")}
+
+	cfg := &config.Config{}
+	if err := editField(cfg, fs, "Logger"); err != nil {
+		t.Fatalf("editField failed: %v", err)
+	}
+	if len(asked) != 1 || asked[0] != "Logger" {
+		t.Errorf("expected only the Logger prompt to run, got %v", asked)
+	}
+	if cfg.Framework != "Logger" {
+		t.Errorf("expected the prompt to update the config, got %q", cfg.Framework)
+	}
+
+	if err := editField(cfg, fs, "Unknown"); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestEditableFields(t *testing.T) {
+	editable := editableFields()
+	if len(editable) != len(fields)+1 {
+		t.Fatalf("expected %d editable fields, got %d", len(fields)+1, len(editable))
+	}
+	if editable[0].label != "Project name" {
+		t.Errorf("expected Project name first, got %q", editable[0].label)
+	}
+
+	seen := map[string]bool{doneOption: true}
+	for _, f := range editable {
+		if seen[f.label] {
+			t.Errorf("duplicate edit option %q", f.label)
+		}
+		seen[f.label] = true
+		if f.ask == nil {
+			t.Errorf("field %q has no prompt", f.label)
+		}
+	}
+}
+
 func TestParseLogger(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -144,20 +222,42 @@ func TestParseLogger(t *testing.T) {
 func TestParseCI(t *testing.T) {
 	tests := []struct {
 		name     string
-		choice   string
+		choices  []string
 		expected string
 	}{
-		{"GitHub Actions", "GitHub Actions", "github"},
-		{"GitLab CI", "GitLab CI", "gitlab"},
-		{"None", "None", ""},
-		{"empty", "", ""},
+		{"GitHub Actions", []string{"GitHub Actions"}, "github"},
+		{"GitLab CI", []string{"GitLab CI"}, "gitlab"},
+		{"both", []string{"GitHub Actions", "GitLab CI"}, "github,gitlab"},
+		{"None", []string{"None"}, ""},
+		{"empty", []string{}, ""},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := parseCI(tt.choice)
+			result := parseCI(tt.choices)
 			if result != tt.expected {
-				t.Errorf("parseCI(%q) = %q, want %q", tt.choice, result, tt.expected)
+				t.Errorf("parseCI(%v) = %q, want %q", tt.choices, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateCIChoices(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"both", []string{"GitHub Actions", "GitLab CI"}, false},
+		{"none only", []string{"None"}, false},
+		{"none mixed with ci", []string{"GitLab CI", "None"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCIChoices(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCIChoices(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 			}
 		})
 	}
@@ -1,7 +1,10 @@
 package test
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -266,3 +269,284 @@ func TestTestingInfrastructureGeneration(t *testing.T) {
 
 	t.Log("✅ Testing infrastructure checks passed!")
 }
+
+// TestStrictConfigValidation builds the generated config loaders and loads a
+// config file with an unknown key, which only strict validation rejects.
+func TestStrictConfigValidation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated projects")
+	}
+
+	unknownKey := map[string]string{
+		"yaml": "app:\n  port: 8080\n  prot: 8081\n",
+		"json": `{"app": {"port": 8080, "prot": 8081}}`,
+		"toml": "[app]\nport = 8080\nprot = 8081\n",
+	}
+
+	for format, content := range unknownKey {
+		for _, validation := range []string{"strict", "lenient"} {
+			t.Run(format+"/"+validation, func(t *testing.T) {
+				cfg := &config.Config{
+					ProjectName:      "test-strict",
+					ModulePath:       "github.com/test/test-strict",
+					GoVersion:        "1.23",
+					Framework:        "stdlib",
+					Logger:           "slog",
+					ConfigFormat:     format,
+					ConfigValidation: validation,
+				}
+
+				outputDir := t.TempDir()
+				if err := generator.New(cfg, outputDir).Generate(); err != nil {
+					t.Fatalf("Failed to generate project: %v", err)
+				}
+				projectDir := filepath.Join(outputDir, "test-strict")
+
+				if err := os.WriteFile(filepath.Join(projectDir, "config."+format), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+				loadTest := fmt.Sprintf(`package config
+
+import "testing"
+
+func TestLoadUnknownKey(t *testing.T) {
+	t.Setenv("CONFIG_PATH", "../../config.%s")
+	_, err := Load()
+	if wantErr := %t; (err != nil) != wantErr {
+		t.Fatalf("Load() error = %%v, want error: %%t", err, wantErr)
+	}
+}
+`, format, validation == "strict")
+				if err := os.WriteFile(filepath.Join(projectDir, "internal/config/load_test.go"), []byte(loadTest), 0644); err != nil {
+					t.Fatal(err)
+				}
+
+				tidy := exec.Command("go", "mod", "tidy")
+				tidy.Dir = projectDir
+				if out, err := tidy.CombinedOutput(); err != nil {
+					t.Skipf("cannot download dependencies: %v\n%s", err, out)
+				}
+
+				test := exec.Command("go", "test", "-run", "TestLoadUnknownKey", "./internal/config")
+				test.Dir = projectDir
+				if out, err := test.CombinedOutput(); err != nil {
+					t.Errorf("generated Load() with %s validation:\n%s", validation, out)
+				}
+			})
+		}
+	}
+}
+
+// TestGeneratedObservabilityTests runs the observability tests generated into
+// projects with the default tracing and metrics enabled.
+func TestGeneratedObservabilityTests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated projects")
+	}
+
+	tests := []struct {
+		framework string
+		format    string
+	}{
+		{"stdlib", "env"},
+		{"stdlib", "yaml"},
+		{"stdlib", "hcl"},
+		{"chi", "env"},
+		{"gin", "env"},
+		{"echo", "env"},
+		{"fiber", "toml"},
+		{"fasthttp", "json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.framework+"/"+tt.format, func(t *testing.T) {
+			cfg := &config.Config{
+				ProjectName:   "test-obs",
+				ModulePath:    "github.com/test/test-obs",
+				GoVersion:     "1.23",
+				Framework:     tt.framework,
+				Logger:        "slog",
+				ConfigFormat:  tt.format,
+				EnableTracing: true,
+				EnableMetrics: true,
+			}
+
+			runGeneratedTests(t, cfg, "./internal/observability")
+		})
+	}
+}
+
+// TestGeneratedMiddlewareTests runs the middleware tests generated into
+// net/http projects, whose Recoverer and Chain tests share one Observability
+// with tracing and metrics enabled.
+func TestGeneratedMiddlewareTests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated projects")
+	}
+
+	for _, disableRecover := range []bool{false, true} {
+		t.Run(fmt.Sprintf("disable-recover=%t", disableRecover), func(t *testing.T) {
+			cfg := &config.Config{
+				ProjectName:    "test-mw",
+				ModulePath:     "github.com/test/test-mw",
+				GoVersion:      "1.23",
+				Framework:      "stdlib",
+				Logger:         "slog",
+				EnableTracing:  true,
+				EnableMetrics:  true,
+				DisableRecover: disableRecover,
+			}
+
+			runGeneratedTests(t, cfg, "./internal/middleware")
+		})
+	}
+}
+
+// TestWebSocketEcho starts the generated server of every framework and checks
+// that /ws completes the WebSocket handshake and echoes a message back through
+// the server's whole middleware stack.
+func TestWebSocketEcho(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated projects")
+	}
+
+	for _, framework := range []string{"stdlib", "chi", "gin", "echo", "fiber", "fasthttp"} {
+		t.Run(framework, func(t *testing.T) {
+			cfg := &config.Config{
+				ProjectName:     "test-ws",
+				ModulePath:      "github.com/test/test-ws",
+				GoVersion:       "1.23",
+				Framework:       framework,
+				Logger:          "slog",
+				EnableMetrics:   true,
+				EnableWebSocket: true,
+			}
+
+			// fiber and fasthttp upgrade through fasthttp/websocket, the others
+			// through coder/websocket
+			wsImport, dialEcho := `"github.com/coder/websocket"`, `conn, _, err := websocket.Dial(ctx, url, nil)
+	if err != nil {
+		return "", err
+	}
+	defer conn.CloseNow()
+	if err := conn.Write(ctx, websocket.MessageText, []byte(message)); err != nil {
+		return "", err
+	}
+	_, reply, err := conn.Read(ctx)
+	return string(reply), err`
+			if framework == "fiber" || framework == "fasthttp" {
+				wsImport, dialEcho = `"github.com/fasthttp/websocket"`, `conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		return "", err
+	}
+	_, reply, err := conn.ReadMessage()
+	return string(reply), err`
+			}
+
+			wsTest := fmt.Sprintf(`package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	%s
+
+	"github.com/test/test-ws/internal/config"
+	"github.com/test/test-ws/internal/observability"
+)
+
+func TestWebSocketEcho(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	ln.Close()
+
+	cfg := &config.Config{Environment: "test", Port: strconv.Itoa(port)}
+	obs, err := observability.New(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(cfg, obs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = s.Start() }()
+	defer s.Shutdown(context.Background())
+
+	url := "ws://127.0.0.1:" + strconv.Itoa(port) + "/ws"
+	for {
+		reply, err := dialEcho(ctx, url, "hello")
+		if err == nil {
+			if reply != "hello" {
+				t.Fatalf("/ws replied %%q, want the message echoed back", reply)
+			}
+			return
+		}
+		// Retry while the server starts listening
+		var opErr *net.OpError
+		if !errors.As(err, &opErr) || ctx.Err() != nil {
+			t.Fatalf("/ws: %%v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func dialEcho(ctx context.Context, url, message string) (string, error) {
+	%s
+}
+`, wsImport, dialEcho)
+
+			runGeneratedTestsWith(t, cfg, map[string]string{"internal/server/websocket_e2e_test.go": wsTest}, "./internal/server")
+		})
+	}
+}
+
+// runGeneratedTests generates a project from cfg and runs the tests of the
+// given packages, skipping when the dependencies can't be downloaded.
+func runGeneratedTests(t *testing.T, cfg *config.Config, packages ...string) {
+	t.Helper()
+	runGeneratedTestsWith(t, cfg, nil, packages...)
+}
+
+// runGeneratedTestsWith is runGeneratedTests with extra test files, keyed by
+// their path in the project, added to the generated ones.
+func runGeneratedTestsWith(t *testing.T, cfg *config.Config, files map[string]string, packages ...string) {
+	t.Helper()
+
+	outputDir := t.TempDir()
+	if err := generator.New(cfg, outputDir).Generate(); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+	projectDir := filepath.Join(outputDir, cfg.ProjectName)
+
+	for path, content := range files {
+		if err := os.WriteFile(filepath.Join(projectDir, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = projectDir
+	if out, err := tidy.CombinedOutput(); err != nil {
+		t.Skipf("cannot download dependencies: %v\n%s", err, out)
+	}
+
+	test := exec.Command("go", append([]string{"test"}, packages...)...)
+	test.Dir = projectDir
+	if out, err := test.CombinedOutput(); err != nil {
+		t.Errorf("generated tests failed:\n%s", out)
+	}
+}